@@ -9,10 +9,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/yaninyzwitty/movie-project-grpc/internal/certs"
 	"github.com/yaninyzwitty/movie-project-grpc/internal/database/pkg"
 	"github.com/yaninyzwitty/movie-project-grpc/internal/helpers"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/telemetry"
 	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -27,18 +31,49 @@ func main() {
 		slog.Error("failed to open file", "error", err)
 		os.Exit(1)
 	}
-	var cfg pkg.Config
+	cfg := pkg.Defaults()
 	if err := cfg.LoadFile(file); err != nil {
 		slog.Error("failed to load file", "error", err)
 		os.Exit(1)
 	}
 
+	// The client traces with the server's tracing settings under its own name
+	shutdownTracing, err := telemetry.Setup(context.Background(), telemetry.Config{
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+		ServiceName: "movie-client",
+	})
+	if err != nil {
+		slog.Error("failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
+
 	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Second)
 	defer cancel()
 
 	address := fmt.Sprintf(":%d", cfg.Server.Port)
 
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	transport := insecure.NewCredentials()
+	if tlsCfg := cfg.Client.TLS; tlsCfg.Enabled {
+		config, err := certs.ClientConfig(tlsCfg.CAPath, tlsCfg.CertPath, tlsCfg.KeyPath, tlsCfg.ServerName)
+		if err != nil {
+			slog.Error("failed to load TLS configuration", "error", err)
+			os.Exit(1)
+		}
+		transport = credentials.NewTLS(config)
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transport),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+	// MOVIE_TOKEN is the JWT the server authenticates the calls with
+	if token := os.Getenv("MOVIE_TOKEN"); token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(token)))
+	}
+	conn, err := grpc.NewClient(address, opts...)
 	if err != nil {
 		slog.Error("failed to connect", "error", err)
 		os.Exit(1)
@@ -0,0 +1,16 @@
+package main
+
+import "context"
+
+// bearerToken attaches a JWT to every call as the authorization metadata.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity is false so the token is also sent when
+// client.tls is off, as in development.
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/database"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/database/pkg"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/secrets"
+)
+
+// passwordProvider returns where the database password comes from, the
+// configured source or else the password loaded with the configuration.
+func passwordProvider(cfg *pkg.Config) (secrets.Provider, error) {
+	if cfg.Database.PasswordSource == "" {
+		return secrets.NewStaticProvider(cfg.Database.Password), nil
+	}
+	return secrets.Parse(cfg.Database.PasswordSource)
+}
+
+// openRotatingSession connects to the database of the astra or cassandra
+// driver and reconnects whenever the password changes, until ctx is done.
+func openRotatingSession(ctx context.Context, cfg *pkg.Config, observer database.Observer) (*database.RotatingSession, error) {
+	secret, err := passwordProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// Requests still running on a replaced session get the request timeout
+	// to finish before it is closed
+	session, err := database.NewRotatingSession(ctx, secret, cfg.Server.Timeout, func(password string) (*gocql.Session, error) {
+		return openSession(cfg, password, observer)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Database.PasswordSource != "" {
+		go session.Watch(ctx, cfg.Database.PasswordRefresh)
+	}
+	return session, nil
+}
+
+// openSession connects to the database of the astra or cassandra driver,
+// observer may be nil.
+func openSession(cfg *pkg.Config, password string, observer database.Observer) (*gocql.Session, error) {
+	switch cfg.Database.Driver {
+	case "", "astra":
+		astraConn := database.NewAstraDb()
+
+		config := &database.AstraConfig{
+			Path:     cfg.Database.Path,
+			Keyspace: cfg.Database.Keyspace,
+			Username: cfg.Database.Username,
+			Password: password,
+			Timeout:  cfg.Server.Timeout,
+			Observer: observer,
+		}
+		session, err := astraConn.CreateDBConn(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to Astra DB: %w", err)
+		}
+		return session, nil
+	case "cassandra":
+		cassandraConn := database.NewCassandraDb()
+
+		config := &database.CassandraConfig{
+			Hosts:                  cfg.Database.Hosts,
+			Port:                   cfg.Database.Port,
+			Keyspace:               cfg.Database.Keyspace,
+			Username:               cfg.Database.Username,
+			Password:               password,
+			Consistency:            cfg.Database.Consistency,
+			LocalDC:                cfg.Database.LocalDC,
+			CertPath:               cfg.Database.TLS.CertPath,
+			KeyPath:                cfg.Database.TLS.KeyPath,
+			CAPath:                 cfg.Database.TLS.CAPath,
+			EnableHostVerification: cfg.Database.TLS.EnableHostVerification,
+			Timeout:                cfg.Server.Timeout,
+			Observer:               observer,
+		}
+		session, err := cassandraConn.CreateDBConn(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to Cassandra: %w", err)
+		}
+		return session, nil
+	default:
+		return nil, fmt.Errorf("database driver %q has no session", cfg.Database.Driver)
+	}
+}
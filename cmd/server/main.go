@@ -2,66 +2,157 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
 	"github.com/joho/godotenv"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/auth"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/certs"
 	"github.com/yaninyzwitty/movie-project-grpc/internal/controllers"
 	"github.com/yaninyzwitty/movie-project-grpc/internal/database"
 	"github.com/yaninyzwitty/movie-project-grpc/internal/database/pkg"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/metrics"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/search"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/telemetry"
 	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-)
-
-var (
-	s string
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
-	var cfg pkg.Config
-	file, err := os.Open("config.yaml")
-	if err != nil {
-		slog.Error("failed to open config.yaml")
-		os.Exit(1)
-	}
-	defer file.Close()
-	if err := cfg.LoadFile(file); err != nil {
-		slog.Error("failed to load config file", "error", err)
-		os.Exit(1)
-	}
 	// you dont require godotenv.Load() here when using docker and docker compose
-	err = godotenv.Load()
+	err := godotenv.Load()
 	if err != nil {
 		slog.Error("failed to load .env file", "value", err)
 
 	}
 
-	if password := os.Getenv("ASTRA_DB_PASSWORD"); password != "" {
-		s = password
+	cfg, args, err := pkg.Load(os.Args[1:], os.Getenv)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		slog.Error("failed to load configuration", "error", err)
+		os.Exit(2)
+	}
+	if err := cfg.Validate(); err != nil {
+		slog.Error("invalid configuration", "error", err)
+		os.Exit(2)
+	}
 
+	// "server migrate up|down|status" manages the schema instead of serving
+	if len(args) > 0 && args[0] == "migrate" {
+		os.Exit(runMigrate(cfg, args[1:]))
+	}
+	if len(args) > 0 {
+		slog.Error("unknown command", "command", args[0])
+		os.Exit(2)
 	}
 
 	_, cancel := context.WithTimeout(context.Background(), cfg.Server.Timeout)
 	defer cancel()
-	astraConn := database.NewAstraDb()
 
-	config := &database.AstraConfig{
-		Path:     cfg.Database.Path,
-		Username: cfg.Database.Username,
-		Password: s,
-		Timeout:  cfg.Server.Timeout,
-	}
-	session, err := astraConn.CreateDBConn(config)
+	shutdownTracing, err := telemetry.Setup(context.Background(), telemetry.Config{
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+		ServiceName: cfg.Tracing.ServiceName,
+	})
 	if err != nil {
-		slog.Error("failed to connect to Astra DB", "error", err)
+		slog.Error("failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+	// Flush the spans still buffered once the server stopped
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.Timeout)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("failed to flush spans", "error", err)
+		}
+	}()
+	serverMetrics := metrics.New()
+	var (
+		users      repository.UserRepository
+		categories repository.CategoryRepository
+		movies     repository.MovieRepository
+		keys       repository.IdempotencyRepository
+		apiKeys    repository.APIKeyRepository
+		// session stays nil for the memory driver
+		session *database.RotatingSession
+	)
+
+	switch cfg.Database.Driver {
+	case "memory":
+		// no secure-connect bundle or network needed, data is lost on restart
+		slog.Info("Using in-memory storage")
+		store := repository.NewMemoryStore()
+		users = repository.NewMemoryUserRepository(store)
+		categories = repository.NewMemoryCategoryRepository(store)
+		movies = repository.NewMemoryMovieRepository(store)
+		keys = repository.NewMemoryIdempotencyRepository(store)
+		apiKeys = repository.NewMemoryAPIKeyRepository(store)
+	case "", "astra", "cassandra":
+		watchCtx, stopWatch := context.WithCancel(context.Background())
+		defer stopWatch()
+		session, err = openRotatingSession(watchCtx, cfg, database.Observers(telemetry.NewCQLTracer(), serverMetrics))
+		if err != nil {
+			slog.Error("failed to connect to the database", "error", err)
+			os.Exit(1)
+		}
+
+		defer session.Close()
+
+		// This also covers the movies_by_category backfill both search
+		// engines rely on
+		if err := checkSchema(context.Background(), session.Session(), cfg.Database.Migrations); err != nil {
+			slog.Error("database schema is not ready", "error", err)
+			os.Exit(1)
+		}
+
+		users = repository.NewCassandraUserRepository(session)
+		categories = repository.NewCassandraCategoryRepository(session)
+		movies = repository.NewCassandraMovieRepository(session)
+		keys = repository.NewCassandraIdempotencyRepository(session)
+		apiKeys = repository.NewCassandraAPIKeyRepository(session)
+	default:
+		slog.Error("unknown database driver", "driver", cfg.Database.Driver)
 		os.Exit(1)
 	}
+	if cfg.Auth.APIKeyCacheTTL > 0 {
+		apiKeys = auth.CachedAPIKeys(apiKeys, cfg.Auth.APIKeyCacheTTL)
+	}
 
-	defer session.Close()
+	var engine search.Engine
+	switch cfg.Search.Driver {
+	case "", "embedded":
+		index := search.NewIndex()
+		if err := index.Load(context.Background(), categories, movies); err != nil {
+			slog.Error("failed to build search index", "error", err)
+			os.Exit(1)
+		}
+		// Route movie writes through the index so it stays current
+		movies = search.NewIndexedMovieRepository(movies, index)
+		engine = index
+	case "sai":
+		if session == nil {
+			slog.Error("sai search needs the astra or cassandra database driver")
+			os.Exit(1)
+		}
+		engine = search.NewSAIEngine(session)
+	default:
+		slog.Error("unknown search driver", "driver", cfg.Search.Driver)
+		os.Exit(1)
+	}
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.Port))
 	if err != nil {
@@ -69,14 +160,83 @@ func main() {
 		os.Exit(1)
 	}
 
-	userController := controllers.NewUserController(session)
-	categoryController := controllers.NewCategoryController(session)
-	movieController := controllers.NewMovieController(session)
+	// The metrics interceptors come first so calls auth rejects are counted
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(serverMetrics.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(serverMetrics.StreamServerInterceptor()),
+	}
+	if cfg.Server.TLS.CertPath != "" {
+		reloader, err := certs.NewReloader(cfg.Server.TLS.CertPath, cfg.Server.TLS.KeyPath, cfg.Server.TLS.ClientCAPath)
+		if err != nil {
+			slog.Error("failed to load TLS certificate", "error", err)
+			os.Exit(1)
+		}
+		go reloader.Watch(context.Background(), cfg.Server.TLS.ReloadInterval)
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.ServerConfig())))
+	} else {
+		slog.Warn("TLS is not configured, serving plaintext")
+	}
+
+	authz := auth.AllowAll()
+	if cfg.Auth.Disabled {
+		slog.Warn("Authentication is disabled, every call is accepted")
+	} else {
+		jwks, err := auth.NewJWKSReloader(cfg.Auth.JWKSPath)
+		if err != nil {
+			slog.Error("failed to load JWKS", "error", err)
+			os.Exit(1)
+		}
+		go jwks.Watch(context.Background(), cfg.Auth.JWKSRefresh)
+		verifier := auth.NewVerifier(jwks, auth.VerifierConfig{
+			Issuer:   cfg.Auth.Issuer,
+			Audience: cfg.Auth.Audience,
+			Leeway:   cfg.Auth.Leeway,
+		})
+		allow := auth.Allowlist(cfg.Auth.AllowUnauthenticated)
+		// A call carrying an API key is authenticated by it, any other needs a
+		// bearer token
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(
+				auth.APIKeyUnaryServerInterceptor(apiKeys),
+				auth.UnaryServerInterceptor(verifier, allow),
+			),
+			grpc.ChainStreamInterceptor(
+				auth.APIKeyStreamServerInterceptor(apiKeys),
+				auth.StreamServerInterceptor(verifier, allow),
+			),
+		)
+		authz = auth.NewAuthorizer(cfg.Auth.Admins, cfg.Auth.ServiceAccounts)
+	}
 
-	server := grpc.NewServer()
+	userController := controllers.NewUserController(users, movies, keys, authz)
+	categoryController := controllers.NewCategoryController(categories, keys)
+	movieController := controllers.NewMovieController(movies, users, categories, keys, engine, authz)
+	adminController := controllers.NewAdminController(apiKeys, authz)
+
+	server := grpc.NewServer(opts...)
 	pb.RegisterUserServiceServer(server, userController)
 	pb.RegisterCategoryServiceServer(server, categoryController)
 	pb.RegisterMovieServiceServer(server, movieController)
+	pb.RegisterAdminServiceServer(server, adminController)
+
+	var metricsServer *http.Server
+	if cfg.Metrics.Port != 0 {
+		metricsLis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Metrics.Port))
+		if err != nil {
+			slog.Error("failed to listen for metrics", "error", err)
+			os.Exit(1)
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", serverMetrics.Handler())
+		metricsServer = &http.Server{Handler: mux, ReadHeaderTimeout: cfg.Server.Timeout}
+		go func() {
+			slog.Info("Serving metrics", "port", cfg.Metrics.Port)
+			if err := metricsServer.Serve(metricsLis); !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("metrics listener failed", "error", err)
+			}
+		}()
+	}
 
 	// handle graceful stop, signals etc.
 	sigChan := make(chan os.Signal, 1)
@@ -88,6 +248,9 @@ func main() {
 
 		// Gracefully stop the gRPC server
 		server.GracefulStop()
+		if metricsServer != nil {
+			metricsServer.Close()
+		}
 		cancel()
 
 		slog.Info("gRPC server has been stopped gracefully")
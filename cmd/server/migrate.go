@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/database/migrations"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/database/pkg"
+)
+
+// runMigrate handles "migrate up|down|status" and returns the exit code.
+func runMigrate(cfg *pkg.Config, args []string) int {
+	if len(args) != 1 || (args[0] != "up" && args[0] != "down" && args[0] != "status") {
+		fmt.Fprintln(os.Stderr, "usage: server migrate up|down|status")
+		return 2
+	}
+	if cfg.Database.Driver == "memory" {
+		slog.Error("the memory driver has no schema to migrate")
+		return 1
+	}
+
+	ctx := context.Background()
+	secret, err := passwordProvider(cfg)
+	if err != nil {
+		slog.Error("failed to read the database password", "error", err)
+		return 1
+	}
+	password, err := secret.Secret(ctx)
+	if err != nil {
+		slog.Error("failed to read the database password", "error", err)
+		return 1
+	}
+	session, err := openSession(cfg, password, nil)
+	if err != nil {
+		slog.Error("failed to connect to the database", "error", err)
+		return 1
+	}
+	defer session.Close()
+
+	migrator, err := migrations.NewMigrator(session)
+	if err != nil {
+		slog.Error("failed to load migrations", "error", err)
+		return 1
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			slog.Info("Applied migration", "version", m.Version, "name", m.Name)
+		}
+		if err != nil {
+			slog.Error("failed to apply migrations", "error", err)
+			return 1
+		}
+		if len(applied) == 0 {
+			slog.Info("Schema is up to date")
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			slog.Error("failed to revert migration", "error", err)
+			return 1
+		}
+		if reverted == nil {
+			slog.Info("No migration to revert")
+		} else {
+			slog.Info("Reverted migration", "version", reverted.Version, "name", reverted.Name)
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			slog.Error("failed to read migration status", "error", err)
+			return 1
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+		w.Flush()
+	}
+	return 0
+}
+
+// checkSchema compares the database schema with the embedded migrations
+// according to the database.migrations setting.
+func checkSchema(ctx context.Context, session *gocql.Session, mode string) error {
+	switch mode {
+	case "", "ignore":
+		return nil
+	case "check", "auto":
+	default:
+		return fmt.Errorf("unknown migrations mode %q", mode)
+	}
+
+	migrator, err := migrations.NewMigrator(session)
+	if err != nil {
+		return err
+	}
+
+	if mode == "auto" {
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			slog.Info("Applied migration", "version", m.Version, "name", m.Name)
+		}
+		return err
+	}
+
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%d migrations are pending, starting with %04d_%s, run \"server migrate up\"", len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
+}
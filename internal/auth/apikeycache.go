@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+)
+
+// cachedAPIKeyRepository remembers the keys GetAPIKey returned for a short
+// while, so authenticating a call does not read the database every time.
+type cachedAPIKeyRepository struct {
+	repository.APIKeyRepository
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[gocql.UUID]cachedAPIKey
+}
+
+type cachedAPIKey struct {
+	key     repository.APIKey
+	expires time.Time
+}
+
+// CachedAPIKeys caches the keys looked up through the returned repository
+// for ttl. A key revoked through it stops working at once, a key revoked by
+// another server instance only once its cache entry expired.
+func CachedAPIKeys(keys repository.APIKeyRepository, ttl time.Duration) repository.APIKeyRepository {
+	return &cachedAPIKeyRepository{
+		APIKeyRepository: keys,
+		ttl:              ttl,
+		now:              time.Now,
+		entries:          make(map[gocql.UUID]cachedAPIKey),
+	}
+}
+
+func (r *cachedAPIKeyRepository) GetAPIKey(ctx context.Context, id gocql.UUID) (*repository.APIKey, error) {
+	now := r.now()
+	r.mu.Lock()
+	entry, ok := r.entries[id]
+	r.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		key := entry.key
+		return &key, nil
+	}
+
+	key, err := r.APIKeyRepository.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Drop what expired so keys no longer used do not pile up
+	for cached, e := range r.entries {
+		if !now.Before(e.expires) {
+			delete(r.entries, cached)
+		}
+	}
+	r.entries[id] = cachedAPIKey{key: *key, expires: now.Add(r.ttl)}
+	return key, nil
+}
+
+func (r *cachedAPIKeyRepository) RevokeAPIKey(ctx context.Context, id gocql.UUID, at time.Time) error {
+	err := r.APIKeyRepository.RevokeAPIKey(ctx, id, at)
+	r.mu.Lock()
+	delete(r.entries, id)
+	r.mu.Unlock()
+	return err
+}
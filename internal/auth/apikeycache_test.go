@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// countingKeys counts the lookups that reach the repository it wraps.
+type countingKeys struct {
+	repository.APIKeyRepository
+	gets int
+	err  error
+}
+
+func (r *countingKeys) GetAPIKey(ctx context.Context, id gocql.UUID) (*repository.APIKey, error) {
+	r.gets++
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.APIKeyRepository.GetAPIKey(ctx, id)
+}
+
+// newCachedKeys returns a cache with a clock the test moves with advance.
+func newCachedKeys(ttl time.Duration) (inner *countingKeys, cached *cachedAPIKeyRepository, advance func(time.Duration)) {
+	inner = &countingKeys{APIKeyRepository: repository.NewMemoryAPIKeyRepository(repository.NewMemoryStore())}
+	cached = CachedAPIKeys(inner, ttl).(*cachedAPIKeyRepository)
+	now := time.Now()
+	cached.now = func() time.Time { return now }
+	return inner, cached, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestCachedAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	get := pb.MovieService_GetMovie_FullMethodName
+
+	t.Run("hits", func(t *testing.T) {
+		inner, cached, advance := newCachedKeys(time.Minute)
+		key, _ := storeKey(t, inner, "movies:read")
+		for i := 0; i < 3; i++ {
+			if _, err := authenticateAPIKey(withKeys(key), cached, get); err != nil {
+				t.Fatalf("authenticate: %v", err)
+			}
+		}
+		if inner.gets != 1 {
+			t.Errorf("got %d lookups, want 1", inner.gets)
+		}
+		advance(time.Minute)
+		if _, err := authenticateAPIKey(withKeys(key), cached, get); err != nil {
+			t.Fatalf("authenticate: %v", err)
+		}
+		if inner.gets != 2 {
+			t.Errorf("got %d lookups after the TTL, want 2", inner.gets)
+		}
+	})
+
+	t.Run("revoked elsewhere", func(t *testing.T) {
+		inner, cached, advance := newCachedKeys(time.Minute)
+		key, id := storeKey(t, inner, "movies:read")
+		if _, err := authenticateAPIKey(withKeys(key), cached, get); err != nil {
+			t.Fatalf("authenticate: %v", err)
+		}
+		// Another instance revokes the key, this one learns of it with the TTL
+		if err := inner.RevokeAPIKey(ctx, id, time.Now()); err != nil {
+			t.Fatalf("RevokeAPIKey: %v", err)
+		}
+		advance(59 * time.Second)
+		if _, err := authenticateAPIKey(withKeys(key), cached, get); err != nil {
+			t.Errorf("got %v within the TTL, want the cached key to pass", err)
+		}
+		advance(time.Second)
+		if _, err := authenticateAPIKey(withKeys(key), cached, get); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("got %v after the TTL, want Unauthenticated", err)
+		}
+	})
+
+	t.Run("revoked through the cache", func(t *testing.T) {
+		inner, cached, _ := newCachedKeys(time.Minute)
+		key, id := storeKey(t, inner, "movies:read")
+		if _, err := authenticateAPIKey(withKeys(key), cached, get); err != nil {
+			t.Fatalf("authenticate: %v", err)
+		}
+		if err := cached.RevokeAPIKey(ctx, id, time.Now()); err != nil {
+			t.Fatalf("RevokeAPIKey: %v", err)
+		}
+		if _, err := authenticateAPIKey(withKeys(key), cached, get); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("got %v, want Unauthenticated", err)
+		}
+	})
+
+	t.Run("errors not cached", func(t *testing.T) {
+		inner, cached, _ := newCachedKeys(time.Minute)
+		key, _ := storeKey(t, inner, "movies:read")
+		inner.err = errors.New("timed out")
+		if _, err := authenticateAPIKey(withKeys(key), cached, get); status.Code(err) != codes.Unavailable {
+			t.Fatalf("got %v, want Unavailable", err)
+		}
+		inner.err = nil
+		if _, err := authenticateAPIKey(withKeys(key), cached, get); err != nil {
+			t.Errorf("got %v once the database is back", err)
+		}
+	})
+
+	t.Run("expired entries dropped", func(t *testing.T) {
+		inner, cached, advance := newCachedKeys(time.Minute)
+		first, _ := storeKey(t, inner, "movies:read")
+		second, _ := storeKey(t, inner, "movies:read")
+		if _, err := authenticateAPIKey(withKeys(first), cached, get); err != nil {
+			t.Fatalf("authenticate: %v", err)
+		}
+		advance(time.Minute)
+		if _, err := authenticateAPIKey(withKeys(second), cached, get); err != nil {
+			t.Fatalf("authenticate: %v", err)
+		}
+		if len(cached.entries) != 1 {
+			t.Errorf("got %d cache entries, want 1", len(cached.entries))
+		}
+	})
+}
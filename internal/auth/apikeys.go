@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyHeader is the metadata key API keys are sent in.
+const APIKeyHeader = "x-api-key"
+
+// An API key reads "mvk_<id>_<secret>", the id finds the api_keys row and
+// only a hash of the secret is stored.
+const apiKeyPrefix = "mvk_"
+
+// Scopes lists the scopes a key can be given. A write scope also grants the
+// read scope of the same resource.
+var Scopes = []string{
+	"users:read", "users:write",
+	"categories:read", "categories:write",
+	"movies:read", "movies:write",
+}
+
+// methodScopes maps every method an API key may call to the scope it needs.
+// AdminService is left out, keys cannot manage keys.
+var methodScopes = map[string]string{
+	pb.UserService_CreateUsers_FullMethodName: "users:write",
+	pb.UserService_GetUser_FullMethodName:     "users:read",
+	pb.UserService_UpdateUser_FullMethodName:  "users:write",
+	pb.UserService_DeleteUser_FullMethodName:  "users:write",
+	pb.UserService_ListUsers_FullMethodName:   "users:read",
+
+	pb.CategoryService_CreateCategories_FullMethodName:  "categories:write",
+	pb.CategoryService_GetCategory_FullMethodName:       "categories:read",
+	pb.CategoryService_GetCategoryByName_FullMethodName: "categories:read",
+	pb.CategoryService_ListCategories_FullMethodName:    "categories:read",
+
+	pb.MovieService_CreateMovies_FullMethodName:                              "movies:write",
+	pb.MovieService_CreateMoviesBidi_FullMethodName:                          "movies:write",
+	pb.MovieService_GetMoviesByUserIDAndCategoryID_FullMethodName:            "movies:read",
+	pb.MovieService_GetMoviesByUserID_FullMethodName:                         "movies:read",
+	pb.MovieService_GetMoviesByUserIDAndName_FullMethodName:                  "movies:read",
+	pb.MovieService_GetMoviesByUserIDAndCategoryIDByCreatedAt_FullMethodName: "movies:read",
+	pb.MovieService_UpdateMovie_FullMethodName:                               "movies:write",
+	pb.MovieService_DeleteMovie_FullMethodName:                               "movies:write",
+	pb.MovieService_DeleteMoviesByCategory_FullMethodName:                    "movies:write",
+	pb.MovieService_GetMovie_FullMethodName:                                  "movies:read",
+	pb.MovieService_ListMoviesByCategory_FullMethodName:                      "movies:read",
+	pb.MovieService_SearchMovies_FullMethodName:                              "movies:read",
+}
+
+type apiKeyKey struct{}
+
+// apiKeyGrant is what the API key a call was authenticated with may do.
+type apiKeyGrant struct {
+	scopes []string
+	// resource is the one of the scope the called method needs, such as
+	// "users" or "movies"
+	resource string
+}
+
+// contextWithAPIKey returns a copy of ctx authenticated as the key with
+// scopes, calling a method that needs scope.
+func contextWithAPIKey(ctx context.Context, id gocql.UUID, scopes []string, scope string) context.Context {
+	resource, _, _ := strings.Cut(scope, ":")
+	ctx = ContextWithSubject(ctx, "apikey:"+id.String())
+	return context.WithValue(ctx, apiKeyKey{}, apiKeyGrant{scopes: scopes, resource: resource})
+}
+
+// ScopesFromContext returns the scopes of the API key the call was
+// authenticated with, ok is false for calls authenticated otherwise.
+func ScopesFromContext(ctx context.Context) ([]string, bool) {
+	grant, ok := ctx.Value(apiKeyKey{}).(apiKeyGrant)
+	return grant.scopes, ok
+}
+
+// HasScope reports whether scopes grant scope.
+func HasScope(scopes []string, scope string) bool {
+	if contains(scopes, scope) {
+		return true
+	}
+	resource, ok := strings.CutSuffix(scope, ":read")
+	return ok && contains(scopes, resource+":write")
+}
+
+// NewAPIKey generates a key and returns it with the id and secret hash to
+// store for it.
+func NewAPIKey() (key string, id gocql.UUID, secretHash []byte, err error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", gocql.UUID{}, nil, err
+	}
+	id = gocql.TimeUUID()
+	encoded := base64.RawURLEncoding.EncodeToString(secret)
+	return apiKeyPrefix + hex.EncodeToString(id[:]) + "_" + encoded, id, hashSecret(encoded), nil
+}
+
+func parseAPIKey(key string) (gocql.UUID, string, error) {
+	rest, ok := strings.CutPrefix(key, apiKeyPrefix)
+	if !ok {
+		return gocql.UUID{}, "", errors.New("malformed API key")
+	}
+	rawID, secret, ok := strings.Cut(rest, "_")
+	if !ok || secret == "" {
+		return gocql.UUID{}, "", errors.New("malformed API key")
+	}
+	idBytes, err := hex.DecodeString(rawID)
+	if err != nil {
+		return gocql.UUID{}, "", errors.New("malformed API key")
+	}
+	id, err := gocql.UUIDFromBytes(idBytes)
+	if err != nil {
+		return gocql.UUID{}, "", errors.New("malformed API key")
+	}
+	return id, secret, nil
+}
+
+func hashSecret(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+// APIKeyUnaryServerInterceptor authenticates unary calls that carry an API
+// key and checks the key's scopes. Calls without one are passed on for the
+// token interceptors to handle.
+func APIKeyUnaryServerInterceptor(keys repository.APIKeyRepository) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticateAPIKey(ctx, keys, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// APIKeyStreamServerInterceptor is APIKeyUnaryServerInterceptor for
+// streaming calls.
+func APIKeyStreamServerInterceptor(keys repository.APIKeyRepository) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticateAPIKey(ss.Context(), keys, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticateAPIKey(ctx context.Context, keys repository.APIKeyRepository, fullMethod string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(APIKeyHeader)
+	switch len(values) {
+	case 0:
+		return ctx, nil
+	case 1:
+	default:
+		return nil, status.Errorf(codes.Unauthenticated, "more than one %s header", APIKeyHeader)
+	}
+
+	id, secret, err := parseAPIKey(values[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	key, err := keys.GetAPIKey(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "unknown API key")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to look up API key: %v", err)
+	}
+	if subtle.ConstantTimeCompare(hashSecret(secret), key.SecretHash) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "unknown API key")
+	}
+	if !key.RevokedAt.IsZero() {
+		return nil, status.Error(codes.Unauthenticated, "API key has been revoked")
+	}
+
+	scope, ok := methodScopes[fullMethod]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "%s cannot be called with an API key", fullMethod)
+	}
+	if !HasScope(key.Scopes, scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "API key %s lacks the %s scope", key.ID, scope)
+	}
+
+	return contextWithAPIKey(ctx, key.ID, key.Scopes, scope), nil
+}
@@ -0,0 +1,229 @@
+package auth
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseAPIKey(t *testing.T) {
+	id := gocql.TimeUUID()
+	rawID := hex.EncodeToString(id[:])
+
+	tests := []struct {
+		name   string
+		key    string
+		secret string
+		valid  bool
+	}{
+		{"valid", "mvk_" + rawID + "_secret", "secret", true},
+		{"underscore in secret", "mvk_" + rawID + "_sec_ret", "sec_ret", true},
+		{"no prefix", rawID + "_secret", "", false},
+		{"other prefix", "key_" + rawID + "_secret", "", false},
+		{"no secret", "mvk_" + rawID, "", false},
+		{"empty secret", "mvk_" + rawID + "_", "", false},
+		{"id not hex", "mvk_" + strings.Repeat("zz", 16) + "_secret", "", false},
+		{"short id", "mvk_" + rawID[:30] + "_secret", "", false},
+		{"long id", "mvk_" + rawID + "00_secret", "", false},
+		{"empty", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotID, secret, err := parseAPIKey(tt.key)
+			if !tt.valid {
+				if err == nil {
+					t.Errorf("parseAPIKey(%q) succeeded, want an error", tt.key)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseAPIKey: %v", err)
+			}
+			if gotID != id || secret != tt.secret {
+				t.Errorf("got %s %q, want %s %q", gotID, secret, id, tt.secret)
+			}
+		})
+	}
+}
+
+func TestNewAPIKey(t *testing.T) {
+	key, id, secretHash, err := NewAPIKey()
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	gotID, secret, err := parseAPIKey(key)
+	if err != nil {
+		t.Fatalf("parseAPIKey(%q): %v", key, err)
+	}
+	if gotID != id {
+		t.Errorf("got id %s, want %s", gotID, id)
+	}
+	if string(hashSecret(secret)) != string(secretHash) {
+		t.Error("the secret of the key does not match the returned hash")
+	}
+
+	other, _, _, err := NewAPIKey()
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	if other == key {
+		t.Error("two calls returned the same key")
+	}
+}
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []string
+		scope  string
+		want   bool
+	}{
+		{"exact read", []string{"movies:read"}, "movies:read", true},
+		{"exact write", []string{"movies:write"}, "movies:write", true},
+		{"write implies read", []string{"movies:write"}, "movies:read", true},
+		{"read does not imply write", []string{"movies:read"}, "movies:write", false},
+		{"other resource", []string{"users:write"}, "movies:read", false},
+		{"none", nil, "movies:read", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasScope(tt.scopes, tt.scope); got != tt.want {
+				t.Errorf("HasScope(%v, %s) = %t, want %t", tt.scopes, tt.scope, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMethodScopes makes sure a method added to a service is given a scope,
+// or it could not be called with any key.
+func TestMethodScopes(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{pb.UserService_ServiceDesc, pb.CategoryService_ServiceDesc, pb.MovieService_ServiceDesc} {
+		var methods []string
+		for _, m := range desc.Methods {
+			methods = append(methods, m.MethodName)
+		}
+		for _, s := range desc.Streams {
+			methods = append(methods, s.StreamName)
+		}
+		for _, m := range methods {
+			fullMethod := "/" + desc.ServiceName + "/" + m
+			scope, ok := methodScopes[fullMethod]
+			if !ok {
+				t.Errorf("%s has no scope", fullMethod)
+				continue
+			}
+			if !contains(Scopes, scope) {
+				t.Errorf("%s needs %s, which is not in Scopes", fullMethod, scope)
+			}
+		}
+	}
+	for fullMethod := range methodScopes {
+		if strings.HasPrefix(fullMethod, "/"+pb.AdminService_ServiceDesc.ServiceName+"/") {
+			t.Errorf("%s of AdminService has a scope", fullMethod)
+		}
+	}
+}
+
+// storeKey creates a key with scopes in keys and returns it.
+func storeKey(t *testing.T, keys repository.APIKeyRepository, scopes ...string) (string, gocql.UUID) {
+	t.Helper()
+	key, id, secretHash, err := NewAPIKey()
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	err = keys.CreateAPIKey(context.Background(), repository.APIKey{
+		ID:         id,
+		Name:       "test",
+		Scopes:     scopes,
+		SecretHash: secretHash,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("CreateAPIKey: %v", err)
+	}
+	return key, id
+}
+
+// withKeys returns an incoming context carrying keys in the API key header.
+func withKeys(keys ...string) context.Context {
+	md := metadata.MD{}
+	for _, k := range keys {
+		md.Append(APIKeyHeader, k)
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctx := context.Background()
+	keys := repository.NewMemoryAPIKeyRepository(repository.NewMemoryStore())
+	reader, readerID := storeKey(t, keys, "movies:read")
+	writer, writerID := storeKey(t, keys, "movies:write")
+	revoked, revokedID := storeKey(t, keys, "movies:write")
+	if err := keys.RevokeAPIKey(ctx, revokedID, time.Now()); err != nil {
+		t.Fatalf("RevokeAPIKey: %v", err)
+	}
+	_, unknownID, _, err := NewAPIKey()
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	unknown := "mvk_" + hex.EncodeToString(unknownID[:]) + "_secret"
+	wrongSecret := reader[:strings.LastIndex(reader, "_")+1] + "guessed"
+
+	get := pb.MovieService_GetMovie_FullMethodName
+	update := pb.MovieService_UpdateMovie_FullMethodName
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		method  string
+		code    codes.Code
+		subject string
+	}{
+		{"no key", context.Background(), get, codes.OK, ""},
+		{"read", withKeys(reader), get, codes.OK, "apikey:" + readerID.String()},
+		{"write", withKeys(writer), update, codes.OK, "apikey:" + writerID.String()},
+		{"write implies read", withKeys(writer), get, codes.OK, "apikey:" + writerID.String()},
+		{"read does not imply write", withKeys(reader), update, codes.PermissionDenied, ""},
+		{"other resource", withKeys(writer), pb.UserService_GetUser_FullMethodName, codes.PermissionDenied, ""},
+		{"admin method", withKeys(writer), pb.AdminService_IssueAPIKey_FullMethodName, codes.PermissionDenied, ""},
+		{"two keys", withKeys(reader, writer), get, codes.Unauthenticated, ""},
+		{"malformed", withKeys("secret"), get, codes.Unauthenticated, ""},
+		{"unknown id", withKeys(unknown), get, codes.Unauthenticated, ""},
+		{"wrong secret", withKeys(wrongSecret), get, codes.Unauthenticated, ""},
+		{"revoked", withKeys(revoked), get, codes.Unauthenticated, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := authenticateAPIKey(tt.ctx, keys, tt.method)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got %v, want %s", err, tt.code)
+			}
+			if err != nil {
+				return
+			}
+			subject, ok := SubjectFromContext(got)
+			if tt.subject == "" {
+				if ok {
+					t.Errorf("got subject %q, want none", subject)
+				}
+				return
+			}
+			if subject != tt.subject {
+				t.Errorf("got subject %q, want %q", subject, tt.subject)
+			}
+			if _, ok := ScopesFromContext(got); !ok {
+				t.Error("the context carries no scopes")
+			}
+		})
+	}
+}
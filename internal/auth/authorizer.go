@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Access int
+
+const (
+	Read Access = iota
+	Write
+)
+
+func (a Access) String() string {
+	if a == Write {
+		return "write"
+	}
+	return "read"
+}
+
+// Role is what a subject may do besides managing its own library, where the
+// subject is the user ID.
+type Role int
+
+const (
+	// RoleOwner can only read and write the movies of the user it is
+	RoleOwner Role = iota
+	// RoleServiceAccount can read the movies of every user
+	RoleServiceAccount
+	// RoleAdmin can read and write the movies of every user
+	RoleAdmin
+)
+
+type Authorizer interface {
+	// AuthorizeUser checks that the caller may access userID and its movies.
+	AuthorizeUser(ctx context.Context, userID gocql.UUID, access Access) error
+	// AuthorizeAll checks that the caller may access the movies of every user,
+	// for requests that are not limited to one user.
+	AuthorizeAll(ctx context.Context, access Access) error
+	// AuthorizeAdmin checks that the caller is a configured admin.
+	AuthorizeAdmin(ctx context.Context) error
+}
+
+type roleAuthorizer struct {
+	roles map[string]Role
+}
+
+// NewAuthorizer gives the admins and service accounts their role, every
+// other subject is an owner.
+func NewAuthorizer(admins, serviceAccounts []string) Authorizer {
+	roles := make(map[string]Role, len(admins)+len(serviceAccounts))
+	for _, subject := range serviceAccounts {
+		roles[subject] = RoleServiceAccount
+	}
+	// A subject listed twice gets the broader role
+	for _, subject := range admins {
+		roles[subject] = RoleAdmin
+	}
+	return &roleAuthorizer{roles: roles}
+}
+
+func (a *roleAuthorizer) AuthorizeUser(ctx context.Context, userID gocql.UUID, access Access) error {
+	subject, role, err := a.caller(ctx)
+	if err != nil {
+		return err
+	}
+	if role.allows(access) {
+		return nil
+	}
+	if owner, err := gocql.ParseUUID(subject); err == nil && owner == userID {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "%s may not %s user %s", subject, access, userID)
+}
+
+func (a *roleAuthorizer) AuthorizeAll(ctx context.Context, access Access) error {
+	subject, role, err := a.caller(ctx)
+	if err != nil {
+		return err
+	}
+	if role.allows(access) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "%s may only %s its own movies", subject, access)
+}
+
+func (a *roleAuthorizer) AuthorizeAdmin(ctx context.Context) error {
+	subject, ok := SubjectFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "this method needs an authenticated caller")
+	}
+	// An API key with a write scope acts like an admin on that resource only
+	if _, isKey := ScopesFromContext(ctx); isKey || a.roles[subject] != RoleAdmin {
+		return status.Errorf(codes.PermissionDenied, "%s is not an admin", subject)
+	}
+	return nil
+}
+
+// caller returns the subject of the call and its role. API keys are not
+// configured, their role follows from their scopes on the resource of the
+// called method: users:write lets a key update and delete every user,
+// movies:write lets it write the movies of every user.
+func (a *roleAuthorizer) caller(ctx context.Context) (string, Role, error) {
+	subject, ok := SubjectFromContext(ctx)
+	if !ok {
+		return "", RoleOwner, status.Error(codes.Unauthenticated, "this method needs an authenticated caller")
+	}
+	if grant, ok := ctx.Value(apiKeyKey{}).(apiKeyGrant); ok {
+		switch {
+		case grant.resource == "":
+			return subject, RoleOwner, nil
+		case HasScope(grant.scopes, grant.resource+":write"):
+			return subject, RoleAdmin, nil
+		case HasScope(grant.scopes, grant.resource+":read"):
+			return subject, RoleServiceAccount, nil
+		default:
+			return subject, RoleOwner, nil
+		}
+	}
+	return subject, a.roles[subject], nil
+}
+
+// allows reports whether the role grants access to the movies of any user.
+func (r Role) allows(access Access) bool {
+	switch r {
+	case RoleAdmin:
+		return true
+	case RoleServiceAccount:
+		return access == Read
+	default:
+		return false
+	}
+}
+
+type allowAll struct{}
+
+// AllowAll authorizes every call, for servers running without
+// authentication.
+func AllowAll() Authorizer {
+	return allowAll{}
+}
+
+func (allowAll) AuthorizeUser(ctx context.Context, userID gocql.UUID, access Access) error {
+	return nil
+}
+
+func (allowAll) AuthorizeAll(ctx context.Context, access Access) error {
+	return nil
+}
+
+func (allowAll) AuthorizeAdmin(ctx context.Context) error {
+	return nil
+}
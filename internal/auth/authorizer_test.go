@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRoleAuthorizer(t *testing.T) {
+	authz := NewAuthorizer([]string{"ops"}, []string{"recommender"})
+	owner := gocql.TimeUUID()
+	other := gocql.TimeUUID()
+
+	as := func(subject string) context.Context {
+		return ContextWithSubject(context.Background(), subject)
+	}
+	// key authenticates like the interceptor does for a method needing scope
+	key := func(scope string, scopes ...string) context.Context {
+		return contextWithAPIKey(context.Background(), gocql.TimeUUID(), scopes, scope)
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		userID gocql.UUID
+		access Access
+		code   codes.Code
+	}{
+		{"anonymous", context.Background(), owner, Read, codes.Unauthenticated},
+		{"owner reads own", as(owner.String()), owner, Read, codes.OK},
+		{"owner writes own", as(owner.String()), owner, Write, codes.OK},
+		{"owner reads other", as(owner.String()), other, Read, codes.PermissionDenied},
+		{"owner writes other", as(owner.String()), other, Write, codes.PermissionDenied},
+		{"service account reads", as("recommender"), other, Read, codes.OK},
+		{"service account writes", as("recommender"), other, Write, codes.PermissionDenied},
+		{"admin writes", as("ops"), other, Write, codes.OK},
+		{"unknown subject", as("mallory"), other, Read, codes.PermissionDenied},
+
+		{"users:write key updates a user", key("users:write", "users:write"), other, Write, codes.OK},
+		{"users:write key reads a user", key("users:read", "users:write"), other, Read, codes.OK},
+		{"users:read key updates a user", key("users:write", "users:read"), other, Write, codes.PermissionDenied},
+		{"users:write key writes movies", key("movies:write", "users:write"), other, Write, codes.PermissionDenied},
+		{"users:write key reads movies", key("movies:read", "users:write", "movies:read"), other, Read, codes.OK},
+		{"movies:write key writes movies", key("movies:write", "movies:write"), other, Write, codes.OK},
+		{"movies:write key updates a user", key("users:write", "movies:write"), other, Write, codes.PermissionDenied},
+		{"movies:read key writes movies", key("movies:write", "movies:read"), other, Write, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authz.AuthorizeUser(tt.ctx, tt.userID, tt.access)
+			if got := status.Code(err); got != tt.code {
+				t.Errorf("AuthorizeUser = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestRoleAuthorizerAll(t *testing.T) {
+	authz := NewAuthorizer([]string{"ops"}, []string{"recommender"})
+	key := func(scope string, scopes ...string) context.Context {
+		return contextWithAPIKey(context.Background(), gocql.TimeUUID(), scopes, scope)
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		access Access
+		code   codes.Code
+	}{
+		{"owner", ContextWithSubject(context.Background(), gocql.TimeUUID().String()), Read, codes.PermissionDenied},
+		{"service account reads", ContextWithSubject(context.Background(), "recommender"), Read, codes.OK},
+		{"service account writes", ContextWithSubject(context.Background(), "recommender"), Write, codes.PermissionDenied},
+		{"admin writes", ContextWithSubject(context.Background(), "ops"), Write, codes.OK},
+		{"movies:read key", key("movies:read", "movies:read"), Read, codes.OK},
+		{"movies:write key", key("movies:write", "movies:write"), Write, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authz.AuthorizeAll(tt.ctx, tt.access)
+			if got := status.Code(err); got != tt.code {
+				t.Errorf("AuthorizeAll = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestRoleAuthorizerAdmin(t *testing.T) {
+	authz := NewAuthorizer([]string{"ops"}, []string{"recommender"})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"anonymous", context.Background(), codes.Unauthenticated},
+		{"admin", ContextWithSubject(context.Background(), "ops"), codes.OK},
+		{"service account", ContextWithSubject(context.Background(), "recommender"), codes.PermissionDenied},
+		{"key with every scope", contextWithAPIKey(context.Background(), gocql.TimeUUID(), Scopes, "movies:write"), codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authz.AuthorizeAdmin(tt.ctx)
+			if got := status.Code(err); got != tt.code {
+				t.Errorf("AuthorizeAdmin = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type subjectKey struct{}
+
+// ContextWithSubject returns a copy of ctx carrying the authenticated subject.
+func ContextWithSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, subjectKey{}, subject)
+}
+
+// SubjectFromContext returns the subject the call was authenticated as, ok is
+// false for calls to an allowlisted method without credentials.
+func SubjectFromContext(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(subjectKey{}).(string)
+	return subject, ok && subject != ""
+}
+
+// Allowlist holds the methods callable without a token. An entry is either
+// a full method name such as "/moviebase.UserService/GetUser" or a whole
+// service such as "/moviebase.UserService/*".
+type Allowlist []string
+
+func (a Allowlist) allows(fullMethod string) bool {
+	for _, entry := range a {
+		if entry == fullMethod {
+			return true
+		}
+		if service, ok := strings.CutSuffix(entry, "*"); ok && strings.HasPrefix(fullMethod, service) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerInterceptor authenticates unary calls with the bearer token of
+// the authorization metadata.
+func UnaryServerInterceptor(verifier Verifier, allow Allowlist) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, verifier, allow, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates streaming calls once, when the
+// stream is opened.
+func StreamServerInterceptor(verifier Verifier, allow Allowlist) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), verifier, allow, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate returns ctx with the subject of the call's token. Allowlisted
+// methods are let through without a token, but a token that is sent anyway
+// still has to be valid. Calls an API key interceptor already authenticated
+// are left alone.
+func authenticate(ctx context.Context, verifier Verifier, allow Allowlist, fullMethod string) (context.Context, error) {
+	if _, ok := SubjectFromContext(ctx); ok {
+		return ctx, nil
+	}
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		if allow.allows(fullMethod) {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	claims, err := verifier.Verify(token, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return ContextWithSubject(ctx, claims.Subject), nil
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	switch len(values) {
+	case 0:
+		return "", nil
+	case 1:
+	default:
+		return "", status.Error(codes.Unauthenticated, "more than one authorization header")
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || strings.TrimSpace(token) == "" {
+		return "", status.Error(codes.Unauthenticated, `authorization must be "Bearer <token>"`)
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// authenticatedStream replaces the context of a server stream.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
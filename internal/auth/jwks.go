@@ -0,0 +1,194 @@
+// Package auth authenticates gRPC calls with JSON Web Tokens signed with
+// HS256 or RS256 by a key from a local JWKS file.
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+)
+
+// Key is one verification key of a JWKS file.
+type Key struct {
+	ID string
+	// Algorithm is HS256 for "oct" keys and RS256 for "RSA" keys
+	Algorithm string
+	secret    []byte
+	public    *rsa.PublicKey
+}
+
+type KeySet []Key
+
+// KeySource hands the verifier the keys to check a token with.
+type KeySource interface {
+	Keys() KeySet
+}
+
+// Keys makes a fixed KeySet a KeySource.
+func (s KeySet) Keys() KeySet {
+	return s
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	K   string `json:"k"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// LoadJWKS reads the keys of the JSON Web Key Set at path. Keys meant for
+// encryption are skipped, any other key that is not a symmetric or an RSA
+// signing key is an error.
+func LoadJWKS(path string) (KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("auth: %s: %w", path, err)
+	}
+
+	var keys KeySet
+	for i, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseKey(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("auth: %s: key %d: %w", path, i, err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("auth: %s has no signing keys", path)
+	}
+	return keys, nil
+}
+
+// JWKSReloader serves the keys of a JWKS file, read again whenever the file
+// changes on disk, so keys can be rotated without a restart.
+type JWKSReloader struct {
+	path string
+
+	mu      sync.RWMutex
+	keys    KeySet
+	modTime time.Time
+}
+
+// NewJWKSReloader loads the keys of the JWKS file at path.
+func NewJWKSReloader(path string) (*JWKSReloader, error) {
+	r := &JWKSReloader{path: path}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *JWKSReloader) Keys() KeySet {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.keys
+}
+
+// Reload reads the file again if it changed since the last load and reports
+// whether it did. On error the keys in use are kept.
+func (r *JWKSReloader) Reload() (bool, error) {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return false, fmt.Errorf("auth: %w", err)
+	}
+
+	r.mu.RLock()
+	changed := r.keys == nil || !r.modTime.Equal(info.ModTime())
+	r.mu.RUnlock()
+	if !changed {
+		return false, nil
+	}
+
+	keys, err := LoadJWKS(r.path)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	r.keys, r.modTime = keys, info.ModTime()
+	r.mu.Unlock()
+	return true, nil
+}
+
+// Watch checks the file every interval until ctx is done.
+func (r *JWKSReloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				slog.Error("failed to reload JWKS", "error", err)
+			} else if reloaded {
+				slog.Info("JWKS reloaded", "path", r.path, "keys", len(r.Keys()))
+			}
+		}
+	}
+}
+
+func parseKey(jwk jsonWebKey) (Key, error) {
+	key := Key{ID: jwk.Kid}
+	switch jwk.Kty {
+	case "oct":
+		if jwk.Alg != "" && jwk.Alg != "HS256" {
+			return Key{}, fmt.Errorf("unsupported algorithm %q for an oct key", jwk.Alg)
+		}
+		secret, err := base64.RawURLEncoding.DecodeString(jwk.K)
+		if err != nil {
+			return Key{}, fmt.Errorf("k: %w", err)
+		}
+		// RFC 7518 asks for a key at least as long as the hash
+		if len(secret) < 32 {
+			return Key{}, fmt.Errorf("an HS256 key needs at least 32 bytes, got %d", len(secret))
+		}
+		key.Algorithm, key.secret = "HS256", secret
+	case "RSA":
+		if jwk.Alg != "" && jwk.Alg != "RS256" {
+			return Key{}, fmt.Errorf("unsupported algorithm %q for an RSA key", jwk.Alg)
+		}
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return Key{}, fmt.Errorf("n: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return Key{}, fmt.Errorf("e: %w", err)
+		}
+		if len(e) > 4 {
+			return Key{}, fmt.Errorf("RSA exponent is too large")
+		}
+		public := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if public.N.BitLen() < 2048 {
+			return Key{}, fmt.Errorf("an RS256 key needs at least 2048 bits, got %d", public.N.BitLen())
+		}
+		if public.E < 3 || public.E%2 == 0 {
+			return Key{}, fmt.Errorf("invalid RSA exponent %d", public.E)
+		}
+		key.Algorithm, key.public = "RS256", public
+	default:
+		return Key{}, fmt.Errorf("unsupported key type %q", jwk.Kty)
+	}
+	return key, nil
+}
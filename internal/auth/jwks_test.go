@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+var (
+	rsaOnce sync.Once
+	rsaKey  *rsa.PrivateKey
+)
+
+// testRSAKey returns an RSA key shared by the tests, generating one is slow.
+func testRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	rsaOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("generate RSA key: %v", err)
+		}
+		rsaKey = key
+	})
+	return rsaKey
+}
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func octJWK(kid string, secret []byte) jsonWebKey {
+	return jsonWebKey{Kty: "oct", Kid: kid, Alg: "HS256", K: base64.RawURLEncoding.EncodeToString(secret)}
+}
+
+func rsaJWK(kid string, public *rsa.PublicKey) jsonWebKey {
+	return jsonWebKey{
+		Kty: "RSA",
+		Kid: kid,
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+	}
+}
+
+// writeJWKS writes keys to path and moves its modification time forward, so
+// a rewrite within the file system's timestamp resolution is still seen.
+func writeJWKS(t *testing.T, path string, keys ...jsonWebKey) {
+	t.Helper()
+	data, err := json.Marshal(map[string][]jsonWebKey{"keys": keys})
+	if err != nil {
+		t.Fatalf("marshal JWKS: %v", err)
+	}
+	writeFile(t, path, data)
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	modTime := time.Now()
+	if info, err := os.Stat(path); err == nil {
+		modTime = info.ModTime().Add(time.Second)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("touch %s: %v", path, err)
+	}
+}
+
+func TestLoadJWKS(t *testing.T) {
+	public := &testRSAKey(t).PublicKey
+
+	tests := []struct {
+		name string
+		keys []jsonWebKey
+		want int
+	}{
+		{"oct and RSA", []jsonWebKey{octJWK("a", testSecret), rsaJWK("b", public)}, 2},
+		{"encryption keys skipped", []jsonWebKey{octJWK("a", testSecret), {Kty: "RSA", Use: "enc"}}, 1},
+		{"no signing keys", []jsonWebKey{{Kty: "RSA", Use: "enc"}}, 0},
+		{"short secret", []jsonWebKey{octJWK("a", testSecret[:16])}, 0},
+		{"small RSA key", []jsonWebKey{rsaJWK("b", &rsa.PublicKey{N: new(big.Int).Lsh(big.NewInt(1), 1023), E: 65537})}, 0},
+		{"even exponent", []jsonWebKey{rsaJWK("b", &rsa.PublicKey{N: public.N, E: 65536})}, 0},
+		{"wrong algorithm", []jsonWebKey{{Kty: "oct", Alg: "RS256", K: octJWK("", testSecret).K}}, 0},
+		{"unsupported key type", []jsonWebKey{{Kty: "EC"}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "jwks.json")
+			writeJWKS(t, path, tt.keys...)
+			keys, err := LoadJWKS(path)
+			if tt.want == 0 {
+				if err == nil {
+					t.Errorf("LoadJWKS succeeded with %d keys, want an error", len(keys))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadJWKS: %v", err)
+			}
+			if len(keys) != tt.want {
+				t.Errorf("got %d keys, want %d", len(keys), tt.want)
+			}
+		})
+	}
+
+	t.Run("malformed", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		writeFile(t, path, []byte("{"))
+		if _, err := LoadJWKS(path); err == nil {
+			t.Error("LoadJWKS succeeded, want an error")
+		}
+	})
+}
+
+func TestJWKSReloader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, octJWK("old", testSecret))
+	jwks, err := NewJWKSReloader(path)
+	if err != nil {
+		t.Fatalf("NewJWKSReloader: %v", err)
+	}
+	v := NewVerifier(jwks, VerifierConfig{})
+	now := time.Now()
+	rotated := signHS256(t, "new", testSecret, claims(now))
+
+	// A token signed by a key the file does not hold yet is rejected
+	if _, err := v.Verify(rotated, now); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("got %v for an unknown kid, want ErrInvalidToken", err)
+	}
+
+	if reloaded, err := jwks.Reload(); err != nil || reloaded {
+		t.Errorf("Reload of an unchanged file = %t, %v, want false", reloaded, err)
+	}
+
+	writeJWKS(t, path, octJWK("old", testSecret), octJWK("new", testSecret))
+	if reloaded, err := jwks.Reload(); err != nil || !reloaded {
+		t.Fatalf("Reload after a rotation = %t, %v, want true", reloaded, err)
+	}
+	if _, err := v.Verify(rotated, now); err != nil {
+		t.Errorf("Verify after the reload: %v", err)
+	}
+
+	// A broken file keeps the keys in use
+	writeFile(t, path, []byte("{"))
+	if _, err := jwks.Reload(); err == nil {
+		t.Error("Reload of a malformed file succeeded")
+	}
+	if _, err := v.Verify(rotated, now); err != nil {
+		t.Errorf("Verify after a failed reload: %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+var ErrInvalidToken = errors.New("auth: invalid token")
+
+// Claims are the registered claims of a verified token.
+type Claims struct {
+	Subject   string
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+}
+
+type VerifierConfig struct {
+	// Issuer, when set, has to match the iss claim
+	Issuer string
+	// Audience, when set, has to be one of the aud claim
+	Audience string
+	// Leeway absorbs clock skew when checking exp and nbf
+	Leeway time.Duration
+}
+
+type Verifier interface {
+	// Verify checks the signature and the claims of a compact serialized
+	// token. Every failure wraps ErrInvalidToken.
+	Verify(token string, now time.Time) (*Claims, error)
+}
+
+type jwtVerifier struct {
+	keys   KeySource
+	config VerifierConfig
+}
+
+// NewVerifier checks tokens against the keys the source holds at the time of
+// each call.
+func NewVerifier(keys KeySource, config VerifierConfig) Verifier {
+	return &jwtVerifier{keys: keys, config: config}
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type payload struct {
+	Sub string          `json:"sub"`
+	Iss string          `json:"iss"`
+	Aud json.RawMessage `json:"aud"`
+	Exp *float64        `json:"exp"`
+	Nbf *float64        `json:"nbf"`
+}
+
+func (v *jwtVerifier) Verify(token string, now time.Time) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, invalid("not a compact JWS")
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, invalid("header: %v", err)
+	}
+	// The algorithm comes from the token, so only the ones a key was loaded
+	// for are accepted, that rules out "none" and HS256 signed with an RSA
+	// public key
+	if h.Alg != "HS256" && h.Alg != "RS256" {
+		return nil, invalid("unsupported algorithm %q", h.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, invalid("signature: %v", err)
+	}
+	if !v.verifySignature(h, parts[0]+"."+parts[1], signature) {
+		return nil, invalid("signature does not match any key")
+	}
+
+	var p payload
+	if err := decodeSegment(parts[1], &p); err != nil {
+		return nil, invalid("claims: %v", err)
+	}
+	audience, err := parseAudience(p.Aud)
+	if err != nil {
+		return nil, invalid("aud: %v", err)
+	}
+	if p.Sub == "" {
+		return nil, invalid("no sub claim")
+	}
+	if p.Exp == nil {
+		return nil, invalid("no exp claim")
+	}
+	expiresAt := numericDate(*p.Exp)
+	if !now.Before(expiresAt.Add(v.config.Leeway)) {
+		return nil, invalid("expired at %s", expiresAt.Format(time.RFC3339))
+	}
+	if p.Nbf != nil {
+		if notBefore := numericDate(*p.Nbf); now.Add(v.config.Leeway).Before(notBefore) {
+			return nil, invalid("not valid before %s", notBefore.Format(time.RFC3339))
+		}
+	}
+	if v.config.Issuer != "" && p.Iss != v.config.Issuer {
+		return nil, invalid("unexpected issuer %q", p.Iss)
+	}
+	if v.config.Audience != "" && !contains(audience, v.config.Audience) {
+		return nil, invalid("audience %q not allowed", v.config.Audience)
+	}
+
+	return &Claims{Subject: p.Sub, Issuer: p.Iss, Audience: audience, ExpiresAt: expiresAt}, nil
+}
+
+// verifySignature tries the key named by kid, or every key of the algorithm
+// when the token names none.
+func (v *jwtVerifier) verifySignature(h header, signed string, signature []byte) bool {
+	digest := sha256.Sum256([]byte(signed))
+	for _, key := range v.keys.Keys() {
+		if key.Algorithm != h.Alg || (h.Kid != "" && key.ID != h.Kid) {
+			continue
+		}
+		switch key.Algorithm {
+		case "HS256":
+			mac := hmac.New(sha256.New, key.secret)
+			mac.Write([]byte(signed))
+			if hmac.Equal(mac.Sum(nil), signature) {
+				return true
+			}
+		case "RS256":
+			if rsa.VerifyPKCS1v15(key.public, crypto.SHA256, digest[:], signature) == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// parseAudience accepts the single string and the array form of aud.
+func parseAudience(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var one string
+	if err := json.Unmarshal(raw, &one); err == nil {
+		return []string{one}, nil
+	}
+	var many []string
+	if err := json.Unmarshal(raw, &many); err != nil {
+		return nil, err
+	}
+	return many, nil
+}
+
+func numericDate(seconds float64) time.Time {
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(frac*1e9))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidToken, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// claims returns valid claims for a token checked at now.
+func claims(now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"sub": "user-1",
+		"iss": "https://auth.example.com/",
+		"aud": "movies",
+		"exp": now.Add(time.Hour).Unix(),
+	}
+}
+
+func encode(t *testing.T, v interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// unsigned returns the header and claims segments of a token.
+func unsigned(t *testing.T, alg, kid string, c map[string]interface{}) string {
+	t.Helper()
+	h := map[string]string{"alg": alg, "typ": "JWT"}
+	if kid != "" {
+		h["kid"] = kid
+	}
+	return encode(t, h) + "." + encode(t, c)
+}
+
+func signHS256(t *testing.T, kid string, secret []byte, c map[string]interface{}) string {
+	t.Helper()
+	signed := unsigned(t, "HS256", kid, c)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, kid string, key *rsa.PrivateKey, c map[string]interface{}) string {
+	t.Helper()
+	signed := unsigned(t, "RS256", kid, c)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestVerify(t *testing.T) {
+	private := testRSAKey(t)
+	var keys KeySet
+	for _, jwk := range []jsonWebKey{octJWK("hmac", testSecret), rsaJWK("rsa", &private.PublicKey)} {
+		key, err := parseKey(jwk)
+		if err != nil {
+			t.Fatalf("parseKey: %v", err)
+		}
+		keys = append(keys, key)
+	}
+	v := NewVerifier(keys, VerifierConfig{
+		Issuer:   "https://auth.example.com/",
+		Audience: "movies",
+		Leeway:   30 * time.Second,
+	})
+	now := time.Now()
+
+	with := func(name string, value interface{}) map[string]interface{} {
+		c := claims(now)
+		if value == nil {
+			delete(c, name)
+		} else {
+			c[name] = value
+		}
+		return c
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"HS256", signHS256(t, "hmac", testSecret, claims(now)), true},
+		{"RS256", signRS256(t, "rsa", private, claims(now)), true},
+		{"RS256 without kid", signRS256(t, "", private, claims(now)), true},
+		{"audience array", signHS256(t, "hmac", testSecret, with("aud", []string{"other", "movies"})), true},
+		{"alg none", unsigned(t, "none", "", claims(now)) + ".", false},
+		{"HS256 with the RSA public key", signHS256(t, "rsa", publicDER, claims(now)), false},
+		{"HS256 with the RSA public key without kid", signHS256(t, "", publicDER, claims(now)), false},
+		{"RS256 by the HMAC kid", signRS256(t, "hmac", private, claims(now)), false},
+		{"unknown kid", signHS256(t, "other", testSecret, claims(now)), false},
+		{"wrong secret", signHS256(t, "hmac", []byte("fedcba9876543210fedcba9876543210"), claims(now)), false},
+		{"not a JWS", "abc.def", false},
+		{"expired", signHS256(t, "hmac", testSecret, with("exp", now.Add(-time.Minute).Unix())), false},
+		{"expired within leeway", signHS256(t, "hmac", testSecret, with("exp", now.Add(-10*time.Second).Unix())), true},
+		{"no exp", signHS256(t, "hmac", testSecret, with("exp", nil)), false},
+		{"not yet valid", signHS256(t, "hmac", testSecret, with("nbf", now.Add(time.Minute).Unix())), false},
+		{"not yet valid within leeway", signHS256(t, "hmac", testSecret, with("nbf", now.Add(10*time.Second).Unix())), true},
+		{"wrong issuer", signHS256(t, "hmac", testSecret, with("iss", "https://evil.example.com/")), false},
+		{"no issuer", signHS256(t, "hmac", testSecret, with("iss", nil)), false},
+		{"wrong audience", signHS256(t, "hmac", testSecret, with("aud", "billing")), false},
+		{"no audience", signHS256(t, "hmac", testSecret, with("aud", nil)), false},
+		{"no subject", signHS256(t, "hmac", testSecret, with("sub", nil)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Verify(tt.token, now)
+			if !tt.valid {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Errorf("got %v, want ErrInvalidToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if got.Subject != "user-1" {
+				t.Errorf("got subject %q, want user-1", got.Subject)
+			}
+		})
+	}
+
+	t.Run("tampered claims", func(t *testing.T) {
+		parts := strings.Split(signHS256(t, "hmac", testSecret, claims(now)), ".")
+		parts[1] = encode(t, with("sub", "admin"))
+		token := strings.Join(parts, ".")
+		if _, err := v.Verify(token, now); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("got %v, want ErrInvalidToken", err)
+		}
+	})
+}
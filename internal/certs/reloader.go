@@ -0,0 +1,160 @@
+// Package certs builds the TLS configurations of the gRPC server and client
+// and keeps the server's certificate current when the files are replaced.
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader serves a certificate and an optional client CA bundle, read again
+// whenever one of their files changes on disk.
+type Reloader struct {
+	certPath string
+	keyPath  string
+	caPath   string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+	modTimes map[string]time.Time
+}
+
+// NewReloader loads the key pair and, when caPath is set, the CA bundle that
+// client certificates are verified against.
+func NewReloader(certPath, keyPath, caPath string) (*Reloader, error) {
+	r := &Reloader{certPath: certPath, keyPath: keyPath, caPath: caPath}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the files again if any of them changed since the last load and
+// reports whether it did. On error the certificate in use is kept.
+func (r *Reloader) Reload() (bool, error) {
+	modTimes := make(map[string]time.Time)
+	for _, path := range r.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return false, fmt.Errorf("certs: %w", err)
+		}
+		modTimes[path] = info.ModTime()
+	}
+
+	r.mu.RLock()
+	changed := r.cert == nil
+	for path, modTime := range modTimes {
+		changed = changed || !r.modTimes[path].Equal(modTime)
+	}
+	r.mu.RUnlock()
+	if !changed {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return false, fmt.Errorf("certs: %w", err)
+	}
+	var clientCA *x509.CertPool
+	if r.caPath != "" {
+		if clientCA, err = LoadCertPool(r.caPath); err != nil {
+			return false, err
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.clientCA, r.modTimes = &cert, clientCA, modTimes
+	r.mu.Unlock()
+	return true, nil
+}
+
+// Watch checks the files every interval until ctx is done.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				slog.Error("failed to reload TLS certificate", "error", err)
+			} else if reloaded {
+				slog.Info("TLS certificate reloaded", "cert", r.certPath)
+			}
+		}
+	}
+}
+
+// ServerConfig returns a TLS configuration that picks up reloaded files on
+// the next handshake. With a CA bundle every client has to present a
+// certificate signed by it.
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+			}
+			if r.clientCA != nil {
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+				config.ClientCAs = r.clientCA
+			}
+			return config, nil
+		},
+	}
+}
+
+func (r *Reloader) paths() []string {
+	if r.caPath == "" {
+		return []string{r.certPath, r.keyPath}
+	}
+	return []string{r.certPath, r.keyPath, r.caPath}
+}
+
+// ClientConfig returns the TLS configuration of a client. caPath replaces the
+// system roots when set, certPath and keyPath are the client certificate for
+// servers that require one and serverName overrides the name the server's
+// certificate is checked against.
+func ClientConfig(caPath, certPath, keyPath, serverName string) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: serverName}
+	if caPath != "" {
+		pool, err := LoadCertPool(caPath)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if certPath != "" || keyPath != "" {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("certs: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// LoadCertPool reads a PEM bundle of CA certificates.
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("certs: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("certs: %s holds no PEM certificates", path)
+	}
+	return pool, nil
+}
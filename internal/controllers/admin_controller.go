@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yaninyzwitty/movie-project-grpc/internal/auth"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AdminController struct {
+	apiKeys repository.APIKeyRepository
+	authz   auth.Authorizer
+	pb.UnimplementedAdminServiceServer
+}
+
+func NewAdminController(apiKeys repository.APIKeyRepository, authz auth.Authorizer) *AdminController {
+	return &AdminController{
+		apiKeys: apiKeys,
+		authz:   authz,
+	}
+}
+
+func (c *AdminController) IssueAPIKey(ctx context.Context, req *pb.IssueAPIKeyRequest) (*pb.IssueAPIKeyResponse, error) {
+	if err := c.authz.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if err := requireFields("name", req.Name); err != nil {
+		return nil, err
+	}
+	if len(req.Scopes) == 0 {
+		return nil, invalidField("scopes", "cannot be empty")
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, scope := range req.Scopes {
+		if !knownScope(scope) {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("scopes[%d]", i),
+				Description: fmt.Sprintf("unknown scope %q", scope),
+			})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, badRequest(violations)
+	}
+
+	key, id, secretHash, err := auth.NewAPIKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate API key: %v", err)
+	}
+	apiKey := repository.APIKey{
+		ID:         id,
+		Name:       req.Name,
+		Scopes:     req.Scopes,
+		SecretHash: secretHash,
+		CreatedAt:  time.Now(),
+	}
+	if err := c.apiKeys.CreateAPIKey(ctx, apiKey); err != nil {
+		return nil, storageError(err, "failed to store API key")
+	}
+
+	return &pb.IssueAPIKeyResponse{
+		ApiKey:  toAPIKeyResponse(apiKey),
+		Key:     key,
+		Message: "API key issued successfully",
+	}, nil
+}
+
+func (c *AdminController) ListAPIKeys(req *pb.ListAPIKeysRequest, stream pb.AdminService_ListAPIKeysServer) error {
+	if err := c.authz.AuthorizeAdmin(stream.Context()); err != nil {
+		return err
+	}
+
+	if req.PageSize <= 0 {
+		return invalidField("page_size", "must be greater than 0")
+	}
+
+	apiKeys, nextPagingState, err := c.apiKeys.ListAPIKeys(stream.Context(), repository.Page{
+		Size:  int(req.PageSize),
+		State: req.PagingState,
+	})
+	if err != nil {
+		return storageError(err, "failed to query API keys")
+	}
+
+	responses := make([]*pb.APIKey, 0, len(apiKeys))
+	for _, k := range apiKeys {
+		responses = append(responses, toAPIKeyResponse(k))
+	}
+
+	if err := stream.Send(&pb.ListAPIKeysResponse{
+		ApiKeys:     responses,
+		Message:     "API keys retrieved successfully",
+		PagingState: nextPagingState,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to send response: %v", err)
+	}
+
+	return nil
+}
+
+func (c *AdminController) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	if err := c.authz.AuthorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.apiKeys.RevokeAPIKey(ctx, id, time.Now()); err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "API key %s not found", id)
+		}
+		return nil, storageError(err, "failed to revoke API key")
+	}
+
+	apiKey, err := c.apiKeys.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, storageError(err, "failed to query API key")
+	}
+
+	return &pb.RevokeAPIKeyResponse{
+		ApiKey:  toAPIKeyResponse(*apiKey),
+		Message: "API key revoked successfully",
+	}, nil
+}
+
+func knownScope(scope string) bool {
+	for _, s := range auth.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func toAPIKeyResponse(k repository.APIKey) *pb.APIKey {
+	response := &pb.APIKey{
+		Id:        k.ID.String(),
+		Name:      k.Name,
+		Scopes:    k.Scopes,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+	if !k.RevokedAt.IsZero() {
+		response.RevokedAt = timestamppb.New(k.RevokedAt)
+	}
+	return response
+}
@@ -1,30 +1,45 @@
 package controllers
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"log/slog"
 
 	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
 	"github.com/yaninyzwitty/movie-project-grpc/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type CategoryController struct {
-	session *gocql.Session
+	categories repository.CategoryRepository
+	keys       repository.IdempotencyRepository
 	pb.UnimplementedCategoryServiceServer
 }
 
-func NewCategoryController(session *gocql.Session) *CategoryController {
+func NewCategoryController(categories repository.CategoryRepository, keys repository.IdempotencyRepository) *CategoryController {
 	return &CategoryController{
-		session: session,
+		categories: categories,
+		keys:       keys,
 	}
 }
 
-func (c *CategoryController) CreateCategories(stream pb.CategoryService_CreateCategoriesServer) error {
-	// Prepare Cassandra statement
-	stmt := `INSERT INTO movie_db.categories (id, name, description) VALUES(?, ?, ?)`
-	batch := c.session.NewBatch(gocql.UnloggedBatch)
+func (c *CategoryController) CreateCategories(stream pb.CategoryService_CreateCategoriesServer) (err error) {
+	var batch []repository.Category
+
+	// Give back the names of categories that were claimed but never written,
+	// so a retry of the stream can claim them again
+	defer func() {
+		if err != nil {
+			c.releaseNames(context.WithoutCancel(stream.Context()), batch)
+		}
+	}()
+	var ids []string
+	seen := make(streamKeys[gocql.UUID])
 	totalCategoriesCreated := 0
+	totalCategoriesReplayed := 0
 
 	for {
 		req, err := stream.Recv()
@@ -35,36 +50,154 @@ func (c *CategoryController) CreateCategories(stream pb.CategoryService_CreateCa
 			return status.Errorf(codes.Internal, "Error while reading client stream: %v", err)
 		}
 
-		if req.Name == "" || req.Description == "" {
-			return status.Errorf(codes.InvalidArgument, "Name and description cannot be empty")
+		if err := requireFields("name", req.Name, "description", req.Description); err != nil {
+			return err
+		}
+		if err := validateIdempotencyKey(req.IdempotencyKey); err != nil {
+			return err
+		}
+
+		// A key repeated within the stream refers to a category of this stream
+		if categoryID, ok := seen.get("categories", req.IdempotencyKey); ok {
+			ids = append(ids, categoryID.String())
+			totalCategoriesReplayed++
+			continue
+		}
+
+		// Generate unique category ID, a retried request gets its original one
+		categoryID, replayed, err := claimKey(stream.Context(), c.keys, "categories", req.IdempotencyKey, gocql.TimeUUID())
+		if err != nil {
+			return err
+		}
+		seen.put("categories", req.IdempotencyKey, categoryID)
+		ids = append(ids, categoryID.String())
+
+		// Only write a replayed category again if the earlier attempt never stored it
+		if replayed {
+			_, err := c.categories.GetCategory(stream.Context(), categoryID)
+			if err == nil {
+				totalCategoriesReplayed++
+				continue
+			}
+			if err != repository.ErrNotFound {
+				return storageError(err, "failed to query category")
+			}
 		}
 
-		// Generate unique category ID
-		categoryID := gocql.TimeUUID()
+		// Reserve the name, a replay of a category that was never written may
+		// already hold it
+		holder, err := c.categories.ClaimCategoryName(stream.Context(), req.Name, categoryID)
+		if err != nil {
+			return storageError(err, "failed to claim category name")
+		}
+		if holder != categoryID {
+			return alreadyExists("category", "categories/"+holder.String(), fmt.Sprintf("a category named %q already exists", req.Name))
+		}
 
-		// Add query to the batch
-		batch.Query(stmt, categoryID, req.Name, req.Description)
+		// Add category to the batch
+		batch = append(batch, repository.Category{ID: categoryID, Name: req.Name, Description: req.Description})
 		totalCategoriesCreated++
 
 		// Flush batch if it exceeds a threshold (e.g., 100 queries)
-		if batch.Size() >= 100 {
-			if err := c.session.ExecuteBatch(batch); err != nil {
-				return status.Errorf(codes.Internal, "Error while executing batch: %v", err)
+		if len(batch) >= 100 {
+			if err := c.categories.CreateCategories(stream.Context(), batch); err != nil {
+				return storageError(err, "Error while executing batch")
 			}
-			batch = c.session.NewBatch(gocql.UnloggedBatch)
+			batch = nil
 		}
 	}
 
 	// Execute any remaining queries in the batch
-	if len(batch.Entries) > 0 {
-		if err := c.session.ExecuteBatch(batch); err != nil {
-			return status.Errorf(codes.Internal, "Error inserting batch: %v", err)
+	if len(batch) > 0 {
+		if err := c.categories.CreateCategories(stream.Context(), batch); err != nil {
+			return storageError(err, "Error inserting batch")
 		}
+		batch = nil
 	}
 
 	// Send response to the client
 	return stream.SendAndClose(&pb.CreateCategoriesResponse{
-		Message:             "Categories created successfully",
-		NoCreatedCategories: int32(totalCategoriesCreated),
+		Message:              "Categories created successfully",
+		NoCreatedCategories:  int32(totalCategoriesCreated),
+		Ids:                  ids,
+		NoReplayedCategories: int32(totalCategoriesReplayed),
+	})
+}
+
+func (c *CategoryController) GetCategory(ctx context.Context, req *pb.GetCategoryRequest) (*pb.GetCategoryResponse, error) {
+	categoryID, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := c.categories.GetCategory(ctx, categoryID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "category with id %s not found", categoryID)
+		}
+		return nil, storageError(err, "failed to query category")
+	}
+
+	return toCategoryResponse(*category), nil
+}
+
+func (c *CategoryController) GetCategoryByName(ctx context.Context, req *pb.GetCategoryByNameRequest) (*pb.GetCategoryResponse, error) {
+	if err := requireFields("name", req.Name); err != nil {
+		return nil, err
+	}
+
+	category, err := c.categories.GetCategoryByName(ctx, req.Name)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "category named %q not found", req.Name)
+		}
+		return nil, storageError(err, "failed to query category")
+	}
+
+	return toCategoryResponse(*category), nil
+}
+
+func (c *CategoryController) ListCategories(req *pb.ListCategoriesRequest, stream pb.CategoryService_ListCategoriesServer) error {
+	if req.PageSize <= 0 {
+		return invalidField("page_size", "must be greater than 0")
+	}
+
+	categories, nextPagingState, err := c.categories.ListCategories(stream.Context(), repository.Page{
+		Size:  int(req.PageSize),
+		State: req.PagingState,
 	})
+	if err != nil {
+		return storageError(err, "failed to query categories")
+	}
+
+	responses := make([]*pb.GetCategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		responses = append(responses, toCategoryResponse(category))
+	}
+
+	if err := stream.Send(&pb.ListCategoriesResponse{
+		Categories:  responses,
+		Message:     "Categories retrieved successfully",
+		PagingState: nextPagingState,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to send response: %v", err)
+	}
+
+	return nil
+}
+
+func (c *CategoryController) releaseNames(ctx context.Context, categories []repository.Category) {
+	for _, category := range categories {
+		if err := c.categories.ReleaseCategoryName(ctx, category.Name, category.ID); err != nil {
+			slog.Warn("failed to release category name", "name", category.Name, "id", category.ID, "error", err)
+		}
+	}
+}
+
+func toCategoryResponse(c repository.Category) *pb.GetCategoryResponse {
+	return &pb.GetCategoryResponse{
+		Id:          c.ID.String(),
+		Name:        c.Name,
+		Description: c.Description,
+	}
 }
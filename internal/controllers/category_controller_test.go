@@ -0,0 +1,103 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateCategoriesClaimsNames(t *testing.T) {
+	s := newTestServer(t)
+	id := s.createCategory(t, "Romance")
+
+	// Names only differing in case and spacing collide
+	stream, err := s.categories.CreateCategories(context.Background())
+	if err != nil {
+		t.Fatalf("CreateCategories: %v", err)
+	}
+	if err := stream.Send(&pb.CreateCategoryRequest{Name: " ROMANCE ", Description: "again"}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	_, err = stream.CloseAndRecv()
+	wantCode(t, err, codes.AlreadyExists)
+
+	got, err := s.categories.GetCategoryByName(context.Background(), &pb.GetCategoryByNameRequest{Name: "romance"})
+	if err != nil {
+		t.Fatalf("GetCategoryByName: %v", err)
+	}
+	if got.Id != id || got.Name != "Romance" {
+		t.Errorf("got category %v, want %s named Romance", got, id)
+	}
+}
+
+func TestCreateCategoriesRepeatedKeyInStream(t *testing.T) {
+	s := newTestServer(t)
+
+	stream, err := s.categories.CreateCategories(context.Background())
+	if err != nil {
+		t.Fatalf("CreateCategories: %v", err)
+	}
+	req := &pb.CreateCategoryRequest{Name: "Horror", Description: "scary", IdempotencyKey: "horror"}
+	for range 2 {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CreateCategories: %v", err)
+	}
+	if resp.NoCreatedCategories != 1 || resp.NoReplayedCategories != 1 || resp.Ids[0] != resp.Ids[1] {
+		t.Errorf("got %v, want one category created and replayed once", resp)
+	}
+}
+
+func TestCreateCategoriesReleasesNamesOnFailure(t *testing.T) {
+	s := newTestServer(t)
+
+	// The second category is rejected, so the stream fails and the name the
+	// first one claimed must be free again
+	stream, err := s.categories.CreateCategories(context.Background())
+	if err != nil {
+		t.Fatalf("CreateCategories: %v", err)
+	}
+	for _, req := range []*pb.CreateCategoryRequest{
+		{Name: "Horror", Description: "scary"},
+		{Name: "Comedy"},
+	} {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	wantCode(t, err, codes.InvalidArgument)
+
+	s.createCategory(t, "Horror")
+}
+
+func TestGetCategory(t *testing.T) {
+	s := newTestServer(t)
+	id := s.createCategory(t, "Drama")
+
+	tests := []struct {
+		name string
+		id   string
+		code codes.Code
+	}{
+		{"existing", id, codes.OK},
+		{"malformed id", "drama", codes.InvalidArgument},
+		{"unknown id", gocql.TimeUUID().String(), codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.categories.GetCategory(context.Background(), &pb.GetCategoryRequest{Id: tt.id})
+			wantCode(t, err, tt.code)
+			if err == nil && got.Name != "Drama" {
+				t.Errorf("got category %v", got)
+			}
+		})
+	}
+}
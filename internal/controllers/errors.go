@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storageError translates an error returned by a repository into a gRPC
+// status error, msg describes the operation that failed.
+func storageError(err error, msg string) error {
+	return status.Errorf(storageCode(err), "%s: %v", msg, err)
+}
+
+func storageCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, repository.ErrNotFound), errors.Is(err, gocql.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, repository.ErrInvalidPagingState):
+		return codes.InvalidArgument
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, gocql.ErrTimeoutNoResponse),
+		errors.Is(err, gocql.ErrTooManyTimeouts):
+		return codes.DeadlineExceeded
+	case errors.Is(err, gocql.ErrUnavailable),
+		errors.Is(err, gocql.ErrNoConnections),
+		errors.Is(err, gocql.ErrSessionClosed),
+		errors.Is(err, gocql.ErrConnectionClosed):
+		return codes.Unavailable
+	}
+
+	var reqErr gocql.RequestError
+	if errors.As(err, &reqErr) {
+		switch reqErr.Code() {
+		case gocql.ErrCodeReadTimeout, gocql.ErrCodeWriteTimeout:
+			return codes.DeadlineExceeded
+		case gocql.ErrCodeUnavailable, gocql.ErrCodeOverloaded, gocql.ErrCodeBootstrapping:
+			return codes.Unavailable
+		case gocql.ErrCodeUnauthorized, gocql.ErrCodeCredentials:
+			// The database rejected the server's own credentials, such as
+			// after a bad password rotation, the caller did nothing wrong
+			return codes.Unavailable
+		}
+	}
+
+	// The server builds every statement itself, so syntax and invalid
+	// query errors are its own bugs
+	return codes.Internal
+}
+
+// invalidField returns an InvalidArgument status carrying a BadRequest
+// detail for a single field violation.
+func invalidField(field, description string) error {
+	return badRequest([]*errdetails.BadRequest_FieldViolation{
+		{Field: field, Description: description},
+	})
+}
+
+func badRequest(violations []*errdetails.BadRequest_FieldViolation) error {
+	fields := make([]string, 0, len(violations))
+	for _, v := range violations {
+		fields = append(fields, fmt.Sprintf("%s %s", v.Field, v.Description))
+	}
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(fields, ", "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// missingReference returns a FailedPrecondition status carrying a
+// PreconditionFailure detail for a reference to a row that does not exist.
+func missingReference(violationType, subject, description string) error {
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("%s: %s", subject, description))
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: violationType, Subject: subject, Description: description},
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// alreadyExists returns an AlreadyExists status carrying a ResourceInfo
+// detail that names the existing row, resourceName includes its id.
+func alreadyExists(resourceType, resourceName, description string) error {
+	st := status.New(codes.AlreadyExists, fmt.Sprintf("%s: %s", resourceName, description))
+	detailed, err := st.WithDetails(&errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Description:  description,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// requireFields takes field name and value pairs and returns an
+// InvalidArgument status listing every field whose value is empty.
+func requireFields(fields ...string) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i+1] == "" {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fields[i],
+				Description: "cannot be empty",
+			})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return badRequest(violations)
+}
+
+// parseUUID parses a required UUID request field.
+func parseUUID(field, value string) (gocql.UUID, error) {
+	if value == "" {
+		return gocql.UUID{}, invalidField(field, "cannot be empty")
+	}
+	id, err := gocql.ParseUUID(value)
+	if err != nil {
+		return gocql.UUID{}, invalidField(field, err.Error())
+	}
+	return id, nil
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"google.golang.org/grpc/codes"
+)
+
+// requestError is an error frame sent by the database.
+type requestError int
+
+func (e requestError) Code() int       { return int(e) }
+func (e requestError) Message() string { return fmt.Sprintf("error 0x%04x", int(e)) }
+func (e requestError) Error() string   { return e.Message() }
+
+func TestStorageCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", repository.ErrNotFound, codes.NotFound},
+		{"wrapped not found", fmt.Errorf("get: %w", gocql.ErrNotFound), codes.NotFound},
+		{"paging state", repository.ErrInvalidPagingState, codes.InvalidArgument},
+		{"canceled", context.Canceled, codes.Canceled},
+		{"deadline", context.DeadlineExceeded, codes.DeadlineExceeded},
+		{"no response", gocql.ErrTimeoutNoResponse, codes.DeadlineExceeded},
+		{"no connections", gocql.ErrNoConnections, codes.Unavailable},
+		{"session closed", gocql.ErrSessionClosed, codes.Unavailable},
+		{"read timeout", requestError(gocql.ErrCodeReadTimeout), codes.DeadlineExceeded},
+		{"write timeout", requestError(gocql.ErrCodeWriteTimeout), codes.DeadlineExceeded},
+		{"unavailable", requestError(gocql.ErrCodeUnavailable), codes.Unavailable},
+		{"overloaded", requestError(gocql.ErrCodeOverloaded), codes.Unavailable},
+		{"bootstrapping", requestError(gocql.ErrCodeBootstrapping), codes.Unavailable},
+		{"unauthorized", requestError(gocql.ErrCodeUnauthorized), codes.Unavailable},
+		{"bad credentials", requestError(gocql.ErrCodeCredentials), codes.Unavailable},
+		{"syntax", requestError(gocql.ErrCodeSyntax), codes.Internal},
+		{"invalid", requestError(gocql.ErrCodeInvalid), codes.Internal},
+		{"server error", requestError(gocql.ErrCodeServer), codes.Internal},
+		{"joined", errors.Join(errors.New("movie 1: ok"), requestError(gocql.ErrCodeWriteTimeout)), codes.DeadlineExceeded},
+		{"unknown", errors.New("boom"), codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := storageCode(tt.err); got != tt.want {
+				t.Errorf("storageCode(%v) = %s, want %s", tt.err, got, tt.want)
+			}
+		})
+	}
+}
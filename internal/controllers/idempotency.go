@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+)
+
+const maxIdempotencyKeyLen = 128
+
+func validateIdempotencyKey(key string) error {
+	if len(key) > maxIdempotencyKeyLen {
+		return invalidField("idempotency_key", fmt.Sprintf("must be at most %d bytes", maxIdempotencyKeyLen))
+	}
+	return nil
+}
+
+// claimKey records the idempotency key of a create request that would be
+// given id. It returns the id the request must use, which is the original one
+// on a replay, and whether the key had been claimed before. Requests without a
+// key always keep id.
+func claimKey(ctx context.Context, keys repository.IdempotencyRepository, scope, key string, id gocql.UUID) (gocql.UUID, bool, error) {
+	if key == "" {
+		return id, false, nil
+	}
+	stored, claimed, err := keys.ClaimKey(ctx, scope, key, id)
+	if err != nil {
+		return gocql.UUID{}, false, storageError(err, "failed to record idempotency key")
+	}
+	return stored, !claimed, nil
+}
+
+// streamKeys remembers what the idempotency keys claimed on one stream
+// resolved to. A key repeated within the stream may belong to a request that
+// is still waiting in an unwritten batch, which the idempotency table cannot
+// tell apart from a claimed key that was never written.
+type streamKeys[T any] map[string]T
+
+func (s streamKeys[T]) get(scope, key string) (T, bool) {
+	if key == "" {
+		var zero T
+		return zero, false
+	}
+	v, ok := s[scope+"\x00"+key]
+	return v, ok
+}
+
+func (s streamKeys[T]) put(scope, key string, v T) {
+	if key != "" {
+		s[scope+"\x00"+key] = v
+	}
+}
+
+// forget drops a key whose request was not written after all, so a later
+// repeat claims it again.
+func (s streamKeys[T]) forget(scope, key string) {
+	delete(s, scope+"\x00"+key)
+}
@@ -1,11 +1,16 @@
 package controllers
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"log/slog"
 	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/auth"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/search"
 	"github.com/yaninyzwitty/movie-project-grpc/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,23 +18,38 @@ import (
 )
 
 type MovieController struct {
-	session *gocql.Session
+	movies     repository.MovieRepository
+	users      repository.UserRepository
+	categories repository.CategoryRepository
+	keys       repository.IdempotencyRepository
+	search     search.Engine
+	// authz decides whose movies the caller may read and write, every method
+	// checks it before touching a user's library
+	authz auth.Authorizer
 	pb.UnimplementedMovieServiceServer
 }
 
-func NewMovieController(session *gocql.Session) *MovieController {
+func NewMovieController(movies repository.MovieRepository, users repository.UserRepository, categories repository.CategoryRepository, keys repository.IdempotencyRepository, engine search.Engine, authz auth.Authorizer) *MovieController {
 	return &MovieController{
-		session: session,
+		movies:     movies,
+		users:      users,
+		categories: categories,
+		keys:       keys,
+		search:     engine,
+		authz:      authz,
 	}
 }
 
 func (c *MovieController) CreateMovies(stream pb.MovieService_CreateMoviesServer) error {
-	// Prepare Cassandra statement for inserting movies
-	stmt := `INSERT INTO movie_db.movies_by_user (user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
-
 	var createdMovies []*pb.MovieResponse
+	totalMoviesReplayed := 0
+
+	// Remember which users and categories exist and which keys were claimed
+	// for the rest of the stream
+	refs := c.newReferenceChecker()
+	seen := make(streamKeys[repository.Movie])
 
-	batch := c.session.NewBatch(gocql.UnloggedBatch)
+	var batch []repository.Movie
 	for {
 		// Receive request from the stream
 		req, err := stream.Recv()
@@ -41,75 +61,279 @@ func (c *MovieController) CreateMovies(stream pb.MovieService_CreateMoviesServer
 			return status.Errorf(codes.Internal, "cannot receive stream request: %v", err)
 		}
 
-		// Validate incoming request
-		if req.UserId == "" || req.CategoryId == "" || req.Name == "" || req.BannerUrl == "" || req.MovieUrl == "" || req.Description == "" {
-			return status.Errorf(codes.InvalidArgument, "invalid request")
+		movie, replayed, err := c.newMovie(stream.Context(), refs, seen, req)
+		if err != nil {
+			return err
+		}
+		if replayed {
+			createdMovies = append(createdMovies, toMovieResponse(movie))
+			totalMoviesReplayed++
+			continue
 		}
 
-		// Generate movie ID and timestamps
-		movieID := gocql.TimeUUID()
-		createdAt := time.Now()
-		updatedAt := time.Now()
-
-		// Add insert query to the batch
-		batch.Query(stmt, req.UserId, movieID, req.CategoryId, req.Name, req.BannerUrl, req.MovieUrl, req.Description, createdAt, updatedAt)
+		// Add the movie to the batch
+		batch = append(batch, movie)
 
 		// Create MovieResponse object to send back in the response
-		createdMovies = append(createdMovies, &pb.MovieResponse{
-			MovieId:     movieID.String(),
-			UserId:      req.UserId,
-			CategoryId:  req.CategoryId,
-			Name:        req.Name,
-			BannerUrl:   req.BannerUrl,
-			MovieUrl:    req.MovieUrl,
-			Description: req.Description,
-		})
+		createdMovies = append(createdMovies, toMovieResponse(movie))
 
 		// If the batch size reaches 100, execute it and create a new batch
-		if batch.Size() >= 100 {
-			if err := c.session.ExecuteBatch(batch); err != nil {
-				return status.Errorf(codes.Internal, "Error while executing batch: %v", err)
+		if len(batch) >= 100 {
+			if err := c.movies.CreateMovies(stream.Context(), batch); err != nil {
+				return storageError(err, "Error while executing batch")
 			}
 			// Reset the batch after execution
-			batch = c.session.NewBatch(gocql.UnloggedBatch)
+			batch = nil
 		}
 	}
 
-	// After finishing the stream, if there are any remaining queries in the batch, execute them
-	if len(batch.Entries) > 0 {
-		if err := c.session.ExecuteBatch(batch); err != nil {
-			return status.Errorf(codes.Internal, "Error inserting final batch: %v", err)
+	// After finishing the stream, if there are any remaining movies in the batch, insert them
+	if len(batch) > 0 {
+		if err := c.movies.CreateMovies(stream.Context(), batch); err != nil {
+			return storageError(err, "Error inserting final batch")
 		}
 	}
 
 	// Send the success response with the created movies
 	return stream.SendAndClose(&pb.CreateMoviesResponse{
-		Message: "Movies created successfully",
-		Movies:  createdMovies,
+		Message:          "Movies created successfully",
+		Movies:           createdMovies,
+		NoReplayedMovies: int32(totalMoviesReplayed),
 	})
 }
 
-func (c *MovieController) GetMoviesByUserIDAndCategoryID(req *pb.GetMoviesRequest, stream pb.MovieService_GetMoviesByUserIDAndCategoryIDServer) error {
-	// Validate input parameters
-	if req.CategoryId == "" || req.UserId == "" {
-		return status.Errorf(codes.InvalidArgument, "categoryId or userId cannot be empty")
+// newMovie validates a create request and turns it into a movie row with a
+// fresh movie ID and timestamps. When the request replays an idempotency key
+// the movie of the first request is returned instead, and the bool reports
+// whether that movie is already stored or, when the first request came on
+// the same stream, about to be.
+func (c *MovieController) newMovie(ctx context.Context, refs *referenceChecker, seen streamKeys[repository.Movie], req *pb.CreateMovieRequest) (repository.Movie, bool, error) {
+	// Validate incoming request
+	if err := requireFields(
+		"user_id", req.UserId,
+		"category_id", req.CategoryId,
+		"name", req.Name,
+		"banner_url", req.BannerUrl,
+		"movie_url", req.MovieUrl,
+		"description", req.Description,
+	); err != nil {
+		return repository.Movie{}, false, err
+	}
+	if err := validateIdempotencyKey(req.IdempotencyKey); err != nil {
+		return repository.Movie{}, false, err
+	}
+
+	userID, err := parseUUID("user_id", req.UserId)
+	if err != nil {
+		return repository.Movie{}, false, err
+	}
+	if err := c.authz.AuthorizeUser(ctx, userID, auth.Write); err != nil {
+		return repository.Movie{}, false, err
+	}
+
+	categoryID, err := parseUUID("category_id", req.CategoryId)
+	if err != nil {
+		return repository.Movie{}, false, err
+	}
+
+	if err := refs.check(ctx, userID, categoryID); err != nil {
+		return repository.Movie{}, false, err
 	}
 
-	categoryID, err := gocql.ParseUUID(req.CategoryId)
+	// Keys are scoped to the user so one user's import cannot collide with another's
+	scope := "movies/" + userID.String()
+	if movie, ok := seen.get(scope, req.IdempotencyKey); ok {
+		return movie, true, nil
+	}
+	movieID, replayed, err := claimKey(ctx, c.keys, scope, req.IdempotencyKey, gocql.TimeUUID())
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid categoryId format: %v", err)
+		return repository.Movie{}, false, err
+	}
+	if replayed {
+		existing, err := c.movies.GetUserMovie(ctx, userID, movieID)
+		if err == nil {
+			seen.put(scope, req.IdempotencyKey, *existing)
+			return *existing, true, nil
+		}
+		if err != repository.ErrNotFound {
+			return repository.Movie{}, false, storageError(err, "failed to query movie")
+		}
+		// The earlier attempt claimed the key but never stored the movie
+	}
+
+	// created_at is taken from the movie ID, so writing a replayed movie again
+	// lands on the same movies_by_user row
+	now := movieID.Time()
+	movie := repository.Movie{
+		UserID:      userID,
+		MovieID:     movieID,
+		CategoryID:  categoryID,
+		Name:        req.Name,
+		BannerURL:   req.BannerUrl,
+		MovieURL:    req.MovieUrl,
+		Description: req.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	seen.put(scope, req.IdempotencyKey, movie)
+	return movie, false, nil
+}
+
+func (c *MovieController) CreateMoviesBidi(stream pb.MovieService_CreateMoviesBidiServer) error {
+	ctx := stream.Context()
+
+	// Receive in the background so requests that are already waiting can be
+	// written as one batch, while a lone request is acknowledged right away
+	reqs := make(chan *pb.CreateMovieRequest, 100)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(reqs)
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case reqs <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	refs := c.newReferenceChecker()
+	seen := make(streamKeys[repository.Movie])
+	index := int32(0)
+
+	// A request repeating the key of a movie still in the batch is
+	// acknowledged together with that movie
+	type pendingMovie struct {
+		index   int32
+		key     string
+		movie   repository.Movie
+		repeats []int32
+	}
+	var batch []pendingMovie
+	pending := make(map[gocql.UUID]int)
+
+	// flush writes the batch and acknowledges every movie in it
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		movies := make([]repository.Movie, 0, len(batch))
+		for _, p := range batch {
+			movies = append(movies, p.movie)
+		}
+		writeErr := c.movies.CreateMovies(ctx, movies)
+		for _, p := range batch {
+			ack := &pb.CreateMovieAck{Index: p.index}
+			if writeErr != nil {
+				// Some movies of the batch may still have been written, a retry
+				// with the same idempotency key tells the client which
+				st := status.Convert(storageError(writeErr, "failed to insert movie"))
+				ack.ErrorCode, ack.ErrorMessage = int32(st.Code()), st.Message()
+				seen.forget("movies/"+p.movie.UserID.String(), p.key)
+			} else {
+				ack.Movie = toMovieResponse(p.movie)
+			}
+			if err := stream.Send(ack); err != nil {
+				return status.Errorf(codes.Internal, "failed to send ack: %v", err)
+			}
+			for _, i := range p.repeats {
+				repeat := &pb.CreateMovieAck{
+					Index:        i,
+					Movie:        ack.Movie,
+					ErrorCode:    ack.ErrorCode,
+					ErrorMessage: ack.ErrorMessage,
+					Replayed:     writeErr == nil,
+				}
+				if err := stream.Send(repeat); err != nil {
+					return status.Errorf(codes.Internal, "failed to send ack: %v", err)
+				}
+			}
+		}
+		batch = nil
+		clear(pending)
+		return nil
+	}
+
+	for req := range reqs {
+		movie, replayed, err := c.newMovie(ctx, refs, seen, req)
+		i, isPending := pending[movie.MovieID]
+		switch {
+		case err != nil:
+			st := status.Convert(err)
+			if err := stream.Send(&pb.CreateMovieAck{
+				Index:        index,
+				ErrorCode:    int32(st.Code()),
+				ErrorMessage: st.Message(),
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to send ack: %v", err)
+			}
+		case replayed && isPending:
+			batch[i].repeats = append(batch[i].repeats, index)
+		case replayed:
+			// Already stored by an earlier request with the same key
+			if err := stream.Send(&pb.CreateMovieAck{
+				Index:    index,
+				Movie:    toMovieResponse(movie),
+				Replayed: true,
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to send ack: %v", err)
+			}
+		default:
+			pending[movie.MovieID] = len(batch)
+			batch = append(batch, pendingMovie{index: index, key: req.IdempotencyKey, movie: movie})
+		}
+		index++
+
+		// Flush once the batch is full or nothing else is waiting
+		if len(batch) >= 100 || len(reqs) == 0 {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
 	}
 
-	userID, err := gocql.ParseUUID(req.UserId)
+	select {
+	case err := <-recvErr:
+		if err != io.EOF {
+			return status.Errorf(codes.Internal, "cannot receive stream request: %v", err)
+		}
+	default:
+		// The receiver stopped because the stream context is done
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	return nil
+}
+
+func (c *MovieController) GetMoviesByUserIDAndCategoryID(req *pb.GetMoviesRequest, stream pb.MovieService_GetMoviesByUserIDAndCategoryIDServer) error {
+	// Validate input parameters
+	categoryID, err := parseUUID("category_id", req.CategoryId)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid userId format: %v", err)
+		return err
 	}
 
-	// Query Cassandra for movies
-	movies, err := c.fetchMovies(userID, categoryID)
+	userID, err := parseUUID("user_id", req.UserId)
 	if err != nil {
 		return err
 	}
+	if err := c.authz.AuthorizeUser(stream.Context(), userID, auth.Read); err != nil {
+		return err
+	}
+
+	// Query the repository for movies
+	movies, err := c.movies.ListMoviesByUserAndCategory(stream.Context(), userID, categoryID)
+	if err != nil {
+		return storageError(err, "failed to query movies")
+	}
 
 	// Handle empty results
 	if len(movies) == 0 {
@@ -118,7 +342,7 @@ func (c *MovieController) GetMoviesByUserIDAndCategoryID(req *pb.GetMoviesReques
 
 	// Send the response
 	if err := stream.Send(&pb.GetMoviesResponse{
-		Movies:  movies,
+		Movies:  toMovieResponses(movies),
 		Message: "Movies retrieved successfully",
 	}); err != nil {
 		return status.Errorf(codes.Internal, "failed to send response: %v", err)
@@ -127,93 +351,31 @@ func (c *MovieController) GetMoviesByUserIDAndCategoryID(req *pb.GetMoviesReques
 	return nil
 }
 
-func (c *MovieController) fetchMovies(userID, categoryID gocql.UUID) ([]*pb.MovieResponse, error) {
-	stmt := `SELECT movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at FROM movie_db.movies_by_user WHERE user_id = ? AND category_id = ?`
-	query := c.session.Query(stmt, userID, categoryID)
-	iter := query.Iter()
-
-	var movies []*pb.MovieResponse
-	var (
-		movieID, catID                         gocql.UUID
-		name, bannerUrl, movieUrl, description string
-		createdAt, updatedAt                   time.Time
-	)
-
-	for iter.Scan(&movieID, &catID, &name, &bannerUrl, &movieUrl, &description, &createdAt, &updatedAt) {
-		movies = append(movies, &pb.MovieResponse{
-			MovieId:     movieID.String(),
-			UserId:      userID.String(),
-			CategoryId:  categoryID.String(),
-			Name:        name,
-			BannerUrl:   bannerUrl,
-			MovieUrl:    movieUrl,
-			Description: description,
-			CreatedAt:   timestamppb.New(createdAt),
-			UpdatedAt:   timestamppb.New(updatedAt),
-		})
-	}
-
-	if err := iter.Close(); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to close iterator: %v", err)
-	}
-
-	return movies, nil
-}
-
 func (c *MovieController) GetMoviesByUserID(req *pb.GetMoviesRequestByUserIDOnly, stream pb.MovieService_GetMoviesByUserIDServer) error {
-	if req.UserId == "" {
-		return status.Errorf(codes.InvalidArgument, "userId cannot be empty")
-	}
-
 	if len(req.PagingState) == 0 {
-		return status.Errorf(codes.InvalidArgument, "pagingState cannot be empty")
+		return invalidField("paging_state", "cannot be empty")
 
 	}
-	userID, err := gocql.ParseUUID(req.UserId)
+	userID, err := parseUUID("user_id", req.UserId)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid userId format: %v", err)
-	}
-
-	stmt := `SELECT movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at 
-			FROM movie_db.movies_by_user 
-			WHERE user_id = ?`
-
-	// todo remeber to set the name as a secondary index local
-	query := c.session.Query(stmt, userID).PageSize(int(req.PageSize))
-	if len(req.PagingState) > 0 {
-		query = query.PageState(req.PagingState)
-
+		return err
 	}
-
-	iter := query.Iter()
-	var movies []*pb.MovieResponse
-	var name, bannerURL, movieURL, description string
-	var createdAt, updatedAt time.Time
-	var movieID, categoryID gocql.UUID
-
-	for iter.Scan(&movieID, &categoryID, &name, &bannerURL, &movieURL, &description, &createdAt, &updatedAt) {
-		movies = append(movies, &pb.MovieResponse{
-			MovieId:     movieID.String(),
-			UserId:      userID.String(),
-			CategoryId:  categoryID.String(),
-			Name:        name,
-			BannerUrl:   bannerURL,
-			MovieUrl:    movieURL,
-			CreatedAt:   timestamppb.New(createdAt),
-			UpdatedAt:   timestamppb.New(updatedAt),
-			Description: description,
-		})
+	if err := c.authz.AuthorizeUser(stream.Context(), userID, auth.Read); err != nil {
+		return err
 	}
 
-	if err := iter.Close(); err != nil {
-		return status.Errorf(codes.Internal, "failed to close iterator: %v", err)
+	// todo remeber to set the name as a secondary index local
+	movies, pagingState, err := c.movies.ListMoviesByUser(stream.Context(), userID, repository.Page{
+		Size:  int(req.PageSize),
+		State: req.PagingState,
+	})
+	if err != nil {
+		return storageError(err, "failed to query movies")
 
 	}
-	// next paging state
-	pagingState := iter.PageState()
 
 	response := &pb.GetMoviesResponseByUserIDOnly{
-		Movies:      movies,
+		Movies:      toMovieResponses(movies),
 		Message:     "Movies retrieved successfully",
 		PagingState: pagingState,
 	}
@@ -228,63 +390,35 @@ func (c *MovieController) GetMoviesByUserID(req *pb.GetMoviesRequestByUserIDOnly
 
 func (c *MovieController) GetMoviesByUserIDAndName(req *pb.GetMoviesRequestByUserIDAndName, stream pb.MovieService_GetMoviesByUserIDAndNameServer) error {
 	// Validate input
-	if req.UserId == "" || req.Name == "" {
-		return status.Errorf(codes.InvalidArgument, "userId and name cannot be empty")
+	if req.Name == "" {
+		return invalidField("name", "cannot be empty")
 	}
 
 	if req.PageSize <= 0 {
-		return status.Errorf(codes.InvalidArgument, "pageSize must be greater than 0")
+		return invalidField("page_size", "must be greater than 0")
 	}
 
-	userID, err := gocql.ParseUUID(req.UserId)
+	userID, err := parseUUID("user_id", req.UserId)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid userId format: %v", err)
+		return err
 	}
-
-	// Prepare query
-	stmt := `SELECT movie_id, category_id, banner_url, movie_url, description, created_at, updated_at FROM movie_db.movies_by_user WHERE user_id = ? AND name = ?`
-	query := c.session.Query(stmt, userID, req.Name).PageSize(int(req.PageSize))
-	if len(req.PagingState) > 0 {
-		query = query.PageState(req.PagingState)
+	if err := c.authz.AuthorizeUser(stream.Context(), userID, auth.Read); err != nil {
+		return err
 	}
 
 	// Execute query
-	iter := query.Iter()
-	var (
-		movies      []*pb.MovieResponse
-		movieID     gocql.UUID
-		categoryID  gocql.UUID
-		bannerURL   string
-		movieURL    string
-		description string
-		createdAt   time.Time
-		updatedAt   time.Time
-	)
-
-	for iter.Scan(&movieID, &categoryID, &bannerURL, &movieURL, &description, &createdAt, &updatedAt) {
-		movies = append(movies, &pb.MovieResponse{
-			UserId:      userID.String(),
-			MovieId:     movieID.String(),
-			CategoryId:  categoryID.String(),
-			Name:        req.Name,
-			BannerUrl:   bannerURL,
-			MovieUrl:    movieURL,
-			Description: description,
-			CreatedAt:   timestamppb.New(createdAt),
-			UpdatedAt:   timestamppb.New(updatedAt),
-		})
-	}
-
-	if err := iter.Close(); err != nil {
-		slog.Error("failed to close the iterator", "error", err)
-		return status.Errorf(codes.Internal, "failed to close iterator: %v", err)
-	}
-
-	nextPagingState := iter.PageState()
+	movies, nextPagingState, err := c.movies.ListMoviesByUserAndName(stream.Context(), userID, req.Name, repository.Page{
+		Size:  int(req.PageSize),
+		State: req.PagingState,
+	})
+	if err != nil {
+		slog.Error("failed to query movies", "error", err)
+		return storageError(err, "failed to query movies")
+	}
 
 	// Send response
 	response := &pb.GetMoviesResponseByUserIDAndName{
-		Movies:      movies,
+		Movies:      toMovieResponses(movies),
 		Message:     "Movies processed successfully",
 		PagingState: nextPagingState,
 	}
@@ -295,3 +429,377 @@ func (c *MovieController) GetMoviesByUserIDAndName(req *pb.GetMoviesRequestByUse
 
 	return nil
 }
+
+func (c *MovieController) GetMoviesByUserIDAndCategoryIDByCreatedAt(req *pb.GetMoviesRequestByUserIDAndCategoryIDByCreatedAt, stream pb.MovieService_GetMoviesByUserIDAndCategoryIDByCreatedAtServer) error {
+	// Validate input
+	if req.PageSize <= 0 {
+		return invalidField("page_size", "must be greater than 0")
+	}
+
+	userID, err := parseUUID("user_id", req.UserId)
+	if err != nil {
+		return err
+	}
+	if err := c.authz.AuthorizeUser(stream.Context(), userID, auth.Read); err != nil {
+		return err
+	}
+
+	categoryID, err := parseUUID("category_id", req.CategoryId)
+	if err != nil {
+		return err
+	}
+
+	// Build the created_at range, either bound may be left open
+	var start, end *time.Time
+
+	if req.StartDate != nil {
+		if err := req.StartDate.CheckValid(); err != nil {
+			return invalidField("start_date", err.Error())
+		}
+		t := req.StartDate.AsTime()
+		start = &t
+	}
+
+	if req.EndDate != nil {
+		if err := req.EndDate.CheckValid(); err != nil {
+			return invalidField("end_date", err.Error())
+		}
+		t := req.EndDate.AsTime()
+		end = &t
+	}
+
+	if start != nil && end != nil && start.After(*end) {
+		return invalidField("start_date", "must not be after end_date")
+	}
+
+	// Execute query
+	movies, nextPagingState, err := c.movies.ListMoviesByUserAndCategoryCreatedBetween(stream.Context(), userID, categoryID, start, end, repository.Page{
+		Size:  int(req.PageSize),
+		State: req.PagingState,
+	})
+	if err != nil {
+		slog.Error("failed to query movies", "error", err)
+		return storageError(err, "failed to query movies")
+	}
+
+	// Send response
+	response := &pb.GetMoviesResponseByUserIDAndCategoryIDByCreatedAt{
+		Movies:      toMovieResponses(movies),
+		Message:     "Movies retrieved successfully",
+		PagingState: nextPagingState,
+	}
+
+	if err := stream.Send(response); err != nil {
+		return status.Errorf(codes.Internal, "failed to send response: %v", err)
+	}
+
+	return nil
+}
+
+func (c *MovieController) UpdateMovie(ctx context.Context, req *pb.UpdateMovieRequest) (*pb.UpdateMovieResponse, error) {
+	// Validate input
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, invalidField("update_mask", "cannot be empty")
+	}
+
+	userID, err := parseUUID("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.authz.AuthorizeUser(ctx, userID, auth.Write); err != nil {
+		return nil, err
+	}
+
+	movieID, err := parseUUID("movie_id", req.MovieId)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := c.getUserMovie(ctx, userID, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply only the fields named in the mask, every updated field must be set
+	movie := *previous
+	for _, path := range req.UpdateMask.Paths {
+		var value string
+		switch path {
+		case "name":
+			value, movie.Name = req.Name, req.Name
+		case "banner_url":
+			value, movie.BannerURL = req.BannerUrl, req.BannerUrl
+		case "movie_url":
+			value, movie.MovieURL = req.MovieUrl, req.MovieUrl
+		case "description":
+			value, movie.Description = req.Description, req.Description
+		case "category_id":
+			categoryID, err := parseUUID("category_id", req.CategoryId)
+			if err != nil {
+				return nil, err
+			}
+			value, movie.CategoryID = req.CategoryId, categoryID
+		default:
+			return nil, invalidField("update_mask", fmt.Sprintf("path %q is not supported", path))
+		}
+		if value == "" {
+			return nil, invalidField(path, "cannot be empty")
+		}
+	}
+	movie.UpdatedAt = time.Now()
+
+	if err := c.movies.UpdateMovie(ctx, *previous, movie); err != nil {
+		return nil, storageError(err, "failed to update movie")
+	}
+
+	return &pb.UpdateMovieResponse{
+		Movie:   toMovieResponse(movie),
+		Message: "Movie updated successfully",
+	}, nil
+}
+
+func (c *MovieController) DeleteMovie(ctx context.Context, req *pb.DeleteMovieRequest) (*pb.DeleteMoviesResponse, error) {
+	// Validate input
+	userID, err := parseUUID("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.authz.AuthorizeUser(ctx, userID, auth.Write); err != nil {
+		return nil, err
+	}
+
+	movieID, err := parseUUID("movie_id", req.MovieId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The primary key also contains category_id and created_at, read them first
+	movie, err := c.getUserMovie(ctx, userID, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.movies.DeleteMovie(ctx, *movie); err != nil {
+		return nil, storageError(err, "failed to delete movie")
+	}
+
+	return &pb.DeleteMoviesResponse{
+		Message:         "Movie deleted successfully",
+		NoDeletedMovies: 1,
+	}, nil
+}
+
+func (c *MovieController) DeleteMoviesByCategory(ctx context.Context, req *pb.DeleteMoviesByCategoryRequest) (*pb.DeleteMoviesResponse, error) {
+	// Validate input
+	userID, err := parseUUID("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.authz.AuthorizeUser(ctx, userID, auth.Write); err != nil {
+		return nil, err
+	}
+
+	categoryID, err := parseUUID("category_id", req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := c.movies.DeleteMoviesByCategory(ctx, userID, categoryID)
+	if err != nil {
+		return nil, storageError(err, "failed to delete movies")
+	}
+
+	return &pb.DeleteMoviesResponse{
+		Message:         "Movies deleted successfully",
+		NoDeletedMovies: int32(deleted),
+	}, nil
+}
+
+func (c *MovieController) GetMovie(ctx context.Context, req *pb.GetMovieRequest) (*pb.MovieResponse, error) {
+	// Validate input
+	movieID, err := parseUUID("movie_id", req.MovieId)
+	if err != nil {
+		return nil, err
+	}
+
+	movie, err := c.movies.GetMovie(ctx, movieID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "movie %s not found", movieID)
+		}
+		return nil, storageError(err, "failed to query movie")
+	}
+	if err := c.authz.AuthorizeUser(ctx, movie.UserID, auth.Read); err != nil {
+		return nil, err
+	}
+
+	return toMovieResponse(*movie), nil
+}
+
+func (c *MovieController) ListMoviesByCategory(req *pb.ListMoviesByCategoryRequest, stream pb.MovieService_ListMoviesByCategoryServer) error {
+	categoryID, err := parseUUID("category_id", req.CategoryId)
+	if err != nil {
+		return err
+	}
+
+	if req.PageSize <= 0 {
+		return invalidField("page_size", "must be greater than 0")
+	}
+
+	// A category holds the movies of every user
+	if err := c.authz.AuthorizeAll(stream.Context(), auth.Read); err != nil {
+		return err
+	}
+
+	movies, nextPagingState, err := c.movies.ListMoviesByCategory(stream.Context(), categoryID, repository.Page{
+		Size:  int(req.PageSize),
+		State: req.PagingState,
+	})
+	if err != nil {
+		return storageError(err, "failed to query movies")
+	}
+
+	if err := stream.Send(&pb.ListMoviesByCategoryResponse{
+		Movies:      toMovieResponses(movies),
+		Message:     "Movies retrieved successfully",
+		PagingState: nextPagingState,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to send response: %v", err)
+	}
+
+	return nil
+}
+
+func (c *MovieController) SearchMovies(ctx context.Context, req *pb.SearchMoviesRequest) (*pb.SearchMoviesResponse, error) {
+	if err := requireFields("query", req.Query); err != nil {
+		return nil, err
+	}
+
+	query := search.Query{Text: req.Query, Limit: int(req.PageSize)}
+	switch {
+	case req.PageSize == 0:
+		query.Limit = 20
+	case req.PageSize < 0 || req.PageSize > 100:
+		return nil, invalidField("page_size", "must be between 1 and 100")
+	}
+
+	// Both filters are optional, but only callers that may read every
+	// library can leave out user_id
+	if req.UserId != "" {
+		userID, err := parseUUID("user_id", req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.authz.AuthorizeUser(ctx, userID, auth.Read); err != nil {
+			return nil, err
+		}
+		query.UserID = &userID
+	} else if err := c.authz.AuthorizeAll(ctx, auth.Read); err != nil {
+		return nil, err
+	}
+	if req.CategoryId != "" {
+		categoryID, err := parseUUID("category_id", req.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		query.CategoryID = &categoryID
+	}
+
+	results, err := c.search.Search(ctx, query)
+	if err != nil {
+		return nil, storageError(err, "failed to search movies")
+	}
+
+	hits := make([]*pb.SearchHit, 0, len(results))
+	for _, r := range results {
+		hits = append(hits, &pb.SearchHit{Movie: toMovieResponse(r.Movie), Score: r.Score})
+	}
+
+	return &pb.SearchMoviesResponse{
+		Hits:    hits,
+		Message: "Movies retrieved successfully",
+	}, nil
+}
+
+// getUserMovie loads a movie and makes sure it belongs to userID, a movie of
+// another user is reported as not found.
+func (c *MovieController) getUserMovie(ctx context.Context, userID, movieID gocql.UUID) (*repository.Movie, error) {
+	movie, err := c.movies.GetUserMovie(ctx, userID, movieID)
+	if err == repository.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "movie %s not found for user %s", movieID, userID)
+	}
+	if err != nil {
+		return nil, storageError(err, "failed to query movie")
+	}
+	return movie, nil
+}
+
+// referenceChecker verifies that the user and category a movie points to
+// exist. Results are cached so a stream looks each id up only once.
+type referenceChecker struct {
+	users      repository.UserRepository
+	categories repository.CategoryRepository
+	userExists map[gocql.UUID]bool
+	catExists  map[gocql.UUID]bool
+}
+
+func (c *MovieController) newReferenceChecker() *referenceChecker {
+	return &referenceChecker{
+		users:      c.users,
+		categories: c.categories,
+		userExists: make(map[gocql.UUID]bool),
+		catExists:  make(map[gocql.UUID]bool),
+	}
+}
+
+func (r *referenceChecker) check(ctx context.Context, userID, categoryID gocql.UUID) error {
+	exists, ok := r.userExists[userID]
+	if !ok {
+		_, err := r.users.GetUser(ctx, userID)
+		if err != nil && err != repository.ErrNotFound {
+			return storageError(err, "failed to query user")
+		}
+		exists = err == nil
+		r.userExists[userID] = exists
+	}
+	if !exists {
+		return missingReference("USER_NOT_FOUND", "users/"+userID.String(), "user_id does not reference an existing user")
+	}
+
+	exists, ok = r.catExists[categoryID]
+	if !ok {
+		_, err := r.categories.GetCategory(ctx, categoryID)
+		if err != nil && err != repository.ErrNotFound {
+			return storageError(err, "failed to query category")
+		}
+		exists = err == nil
+		r.catExists[categoryID] = exists
+	}
+	if !exists {
+		return missingReference("CATEGORY_NOT_FOUND", "categories/"+categoryID.String(), "category_id does not reference an existing category")
+	}
+
+	return nil
+}
+
+func toMovieResponse(m repository.Movie) *pb.MovieResponse {
+	return &pb.MovieResponse{
+		UserId:      m.UserID.String(),
+		MovieId:     m.MovieID.String(),
+		CategoryId:  m.CategoryID.String(),
+		Name:        m.Name,
+		BannerUrl:   m.BannerURL,
+		MovieUrl:    m.MovieURL,
+		Description: m.Description,
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func toMovieResponses(movies []repository.Movie) []*pb.MovieResponse {
+	responses := make([]*pb.MovieResponse, 0, len(movies))
+	for _, m := range movies {
+		responses = append(responses, toMovieResponse(m))
+	}
+	return responses
+}
@@ -0,0 +1,344 @@
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// library creates a user with two categories for the movie tests.
+func (s *testServer) library(t *testing.T) (userID, drama, comedy string) {
+	t.Helper()
+	userID = s.createUsers(t, &pb.CreateUserRequest{Name: "Ada", AliasName: "ada"}).Ids[0]
+	return userID, s.createCategory(t, "Drama"), s.createCategory(t, "Comedy")
+}
+
+func TestCreateMovies(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, _ := s.library(t)
+
+	req := movieRequest(userID, drama, "Metropolis")
+	req.IdempotencyKey = "metropolis"
+	resp := s.createMovies(t, req, movieRequest(userID, drama, "Nosferatu"))
+	if len(resp.Movies) != 2 || resp.NoReplayedMovies != 0 {
+		t.Fatalf("got %d movies, %d replayed", len(resp.Movies), resp.NoReplayedMovies)
+	}
+
+	got, err := s.movies.GetMovie(context.Background(), &pb.GetMovieRequest{MovieId: resp.Movies[0].MovieId})
+	if err != nil {
+		t.Fatalf("GetMovie: %v", err)
+	}
+	if got.Name != "Metropolis" || got.UserId != userID || got.CategoryId != drama {
+		t.Errorf("got movie %v", got)
+	}
+
+	// A retried request returns the stored movie instead of a second copy
+	retry := s.createMovies(t, req)
+	if retry.NoReplayedMovies != 1 || retry.Movies[0].MovieId != resp.Movies[0].MovieId {
+		t.Errorf("retry got %v, want replay of %s", retry, resp.Movies[0].MovieId)
+	}
+}
+
+func TestCreateMoviesManyBatches(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, _ := s.library(t)
+
+	// More movies than the controller writes at once
+	const n = 250
+	reqs := make([]*pb.CreateMovieRequest, n)
+	for i := range reqs {
+		reqs[i] = movieRequest(userID, drama, fmt.Sprintf("movie-%d", i))
+	}
+	resp := s.createMovies(t, reqs...)
+	if len(resp.Movies) != n {
+		t.Fatalf("got %d movies, want %d", len(resp.Movies), n)
+	}
+	if got := s.countByCategory(t, drama); got != n {
+		t.Errorf("got %d movies in the category, want %d", got, n)
+	}
+}
+
+func TestCreateMoviesRejectsMissingReferences(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, _ := s.library(t)
+	unknown := gocql.TimeUUID().String()
+
+	tests := []struct {
+		name string
+		req  *pb.CreateMovieRequest
+		code codes.Code
+	}{
+		{"unknown user", movieRequest(unknown, drama, "Metropolis"), codes.FailedPrecondition},
+		{"unknown category", movieRequest(userID, unknown, "Metropolis"), codes.FailedPrecondition},
+		{"missing name", movieRequest(userID, drama, ""), codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := s.movies.CreateMovies(context.Background())
+			if err != nil {
+				t.Fatalf("CreateMovies: %v", err)
+			}
+			if err := stream.Send(tt.req); err != nil {
+				t.Fatalf("send: %v", err)
+			}
+			_, err = stream.CloseAndRecv()
+			wantCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestCreateMoviesBidi(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, _ := s.library(t)
+
+	stream, err := s.movies.CreateMoviesBidi(context.Background())
+	if err != nil {
+		t.Fatalf("CreateMoviesBidi: %v", err)
+	}
+	reqs := []*pb.CreateMovieRequest{
+		movieRequest(userID, drama, "Metropolis"),
+		movieRequest(userID, drama, ""),
+		movieRequest(userID, drama, "Nosferatu"),
+	}
+	for _, req := range reqs {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend: %v", err)
+	}
+
+	acks := make(map[int32]*pb.CreateMovieAck)
+	for {
+		ack, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		acks[ack.Index] = ack
+	}
+	if len(acks) != len(reqs) {
+		t.Fatalf("got %d acks, want %d", len(acks), len(reqs))
+	}
+
+	// The invalid request fails on its own, the others are stored
+	if acks[1].ErrorCode != int32(codes.InvalidArgument) || acks[1].Movie != nil {
+		t.Errorf("ack 1 = %v, want InvalidArgument", acks[1])
+	}
+	for _, i := range []int32{0, 2} {
+		ack := acks[i]
+		if ack.ErrorCode != 0 || ack.Movie.GetName() != reqs[i].Name {
+			t.Fatalf("ack %d = %v, want %s stored", i, ack, reqs[i].Name)
+		}
+		if _, err := s.movies.GetMovie(context.Background(), &pb.GetMovieRequest{MovieId: ack.Movie.MovieId}); err != nil {
+			t.Errorf("GetMovie %s: %v", reqs[i].Name, err)
+		}
+	}
+}
+
+func TestCreateMoviesRepeatedKeyInStream(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, _ := s.library(t)
+
+	req := movieRequest(userID, drama, "Metropolis")
+	req.IdempotencyKey = "metropolis"
+	resp := s.createMovies(t, req, req)
+	if resp.NoReplayedMovies != 1 || resp.Movies[0].MovieId != resp.Movies[1].MovieId {
+		t.Fatalf("got %v, want the second request replayed", resp)
+	}
+	if n := s.countByCategory(t, drama); n != 1 {
+		t.Errorf("drama lists %d movies, want 1", n)
+	}
+}
+
+func TestCreateMoviesBidiRepeatedKey(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, _ := s.library(t)
+
+	stream, err := s.movies.CreateMoviesBidi(context.Background())
+	if err != nil {
+		t.Fatalf("CreateMoviesBidi: %v", err)
+	}
+	req := movieRequest(userID, drama, "Metropolis")
+	req.IdempotencyKey = "metropolis"
+	for range 3 {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend: %v", err)
+	}
+
+	acks := make(map[int32]*pb.CreateMovieAck)
+	for {
+		ack, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		acks[ack.Index] = ack
+	}
+	if len(acks) != 3 {
+		t.Fatalf("got %d acks, want 3", len(acks))
+	}
+	for i, ack := range acks {
+		if ack.ErrorCode != 0 || ack.Movie.GetMovieId() != acks[0].Movie.GetMovieId() {
+			t.Errorf("ack %d = %v, want movie %s", i, ack, acks[0].Movie.GetMovieId())
+		}
+		if ack.Replayed != (i > 0) {
+			t.Errorf("ack %d replayed = %v", i, ack.Replayed)
+		}
+	}
+	if n := s.countByCategory(t, drama); n != 1 {
+		t.Errorf("drama lists %d movies, want 1", n)
+	}
+}
+
+func TestUpdateMovieMovesCategory(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, comedy := s.library(t)
+	movie := s.createMovies(t, movieRequest(userID, drama, "Metropolis")).Movies[0]
+
+	resp, err := s.movies.UpdateMovie(context.Background(), &pb.UpdateMovieRequest{
+		UserId:     userID,
+		MovieId:    movie.MovieId,
+		CategoryId: comedy,
+		Name:       "Metropolis (restored)",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"category_id", "name"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateMovie: %v", err)
+	}
+	if resp.Movie.CategoryId != comedy || resp.Movie.Name != "Metropolis (restored)" || resp.Movie.Description != movie.Description {
+		t.Errorf("got movie %v", resp.Movie)
+	}
+
+	got, err := s.movies.GetMovie(context.Background(), &pb.GetMovieRequest{MovieId: movie.MovieId})
+	if err != nil {
+		t.Fatalf("GetMovie: %v", err)
+	}
+	if got.CategoryId != comedy {
+		t.Errorf("GetMovie category = %s, want %s", got.CategoryId, comedy)
+	}
+	if n := s.countByCategory(t, drama); n != 0 {
+		t.Errorf("drama still lists %d movies", n)
+	}
+	if n := s.countByCategory(t, comedy); n != 1 {
+		t.Errorf("comedy lists %d movies, want 1", n)
+	}
+}
+
+func TestUpdateMovie(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, _ := s.library(t)
+	movie := s.createMovies(t, movieRequest(userID, drama, "Metropolis")).Movies[0]
+	other := s.createUsers(t, &pb.CreateUserRequest{Name: "Grace", AliasName: "grace"}).Ids[0]
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"name"}}
+
+	tests := []struct {
+		name string
+		req  *pb.UpdateMovieRequest
+		code codes.Code
+	}{
+		{"empty mask", &pb.UpdateMovieRequest{UserId: userID, MovieId: movie.MovieId, Name: "x"}, codes.InvalidArgument},
+		{"unsupported path", &pb.UpdateMovieRequest{UserId: userID, MovieId: movie.MovieId, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user_id"}}}, codes.InvalidArgument},
+		{"empty value", &pb.UpdateMovieRequest{UserId: userID, MovieId: movie.MovieId, UpdateMask: mask}, codes.InvalidArgument},
+		{"unknown movie", &pb.UpdateMovieRequest{UserId: userID, MovieId: gocql.TimeUUID().String(), Name: "x", UpdateMask: mask}, codes.NotFound},
+		{"another user's movie", &pb.UpdateMovieRequest{UserId: other, MovieId: movie.MovieId, Name: "x", UpdateMask: mask}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.movies.UpdateMovie(context.Background(), tt.req)
+			wantCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestDeleteMovie(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, _ := s.library(t)
+	movie := s.createMovies(t, movieRequest(userID, drama, "Metropolis")).Movies[0]
+	other := s.createUsers(t, &pb.CreateUserRequest{Name: "Grace", AliasName: "grace"}).Ids[0]
+
+	_, err := s.movies.DeleteMovie(context.Background(), &pb.DeleteMovieRequest{UserId: other, MovieId: movie.MovieId})
+	wantCode(t, err, codes.NotFound)
+
+	resp, err := s.movies.DeleteMovie(context.Background(), &pb.DeleteMovieRequest{UserId: userID, MovieId: movie.MovieId})
+	if err != nil {
+		t.Fatalf("DeleteMovie: %v", err)
+	}
+	if resp.NoDeletedMovies != 1 {
+		t.Errorf("got %d deleted movies, want 1", resp.NoDeletedMovies)
+	}
+
+	_, err = s.movies.GetMovie(context.Background(), &pb.GetMovieRequest{MovieId: movie.MovieId})
+	wantCode(t, err, codes.NotFound)
+	if n := s.countByCategory(t, drama); n != 0 {
+		t.Errorf("drama still lists %d movies", n)
+	}
+}
+
+func TestListMoviesByCategoryPages(t *testing.T) {
+	s := newTestServer(t)
+	userID, drama, comedy := s.library(t)
+	var reqs []*pb.CreateMovieRequest
+	for range 7 {
+		reqs = append(reqs, movieRequest(userID, drama, "drama"))
+	}
+	reqs = append(reqs, movieRequest(userID, comedy, "comedy"))
+	s.createMovies(t, reqs...)
+
+	if n := s.countByCategory(t, drama); n != 7 {
+		t.Errorf("drama lists %d movies, want 7", n)
+	}
+}
+
+// countByCategory pages through ListMoviesByCategory three movies at a time
+// and returns how many distinct movies it listed.
+func (s *testServer) countByCategory(t *testing.T, categoryID string) int {
+	t.Helper()
+	seen := make(map[string]bool)
+	var state []byte
+	for pages := 0; ; pages++ {
+		if pages > 100 {
+			t.Fatal("paging does not terminate")
+		}
+		stream, err := s.movies.ListMoviesByCategory(context.Background(), &pb.ListMoviesByCategoryRequest{
+			CategoryId:  categoryID,
+			PageSize:    3,
+			PagingState: state,
+		})
+		if err != nil {
+			t.Fatalf("ListMoviesByCategory: %v", err)
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("ListMoviesByCategory: %v", err)
+		}
+		for _, m := range resp.Movies {
+			if m.CategoryId != categoryID {
+				t.Fatalf("movie %s of category %s listed under %s", m.MovieId, m.CategoryId, categoryID)
+			}
+			if seen[m.MovieId] {
+				t.Fatalf("movie %s listed twice", m.MovieId)
+			}
+			seen[m.MovieId] = true
+		}
+		if len(resp.PagingState) == 0 {
+			return len(seen)
+		}
+		state = resp.PagingState
+	}
+}
@@ -0,0 +1,145 @@
+package controllers_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/yaninyzwitty/movie-project-grpc/internal/auth"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/controllers"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/search"
+	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testServer serves the controllers over an in-memory connection, backed by
+// the memory repositories the way the server runs with the memory driver.
+type testServer struct {
+	store      *repository.MemoryStore
+	users      pb.UserServiceClient
+	categories pb.CategoryServiceClient
+	movies     pb.MovieServiceClient
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	return newTestServerWith(t, auth.AllowAll())
+}
+
+// newTestServerWith serves the controllers with authz deciding access to
+// users and their movies.
+func newTestServerWith(t *testing.T, authz auth.Authorizer) *testServer {
+	t.Helper()
+
+	store := repository.NewMemoryStore()
+	users := repository.NewMemoryUserRepository(store)
+	categories := repository.NewMemoryCategoryRepository(store)
+	keys := repository.NewMemoryIdempotencyRepository(store)
+	index := search.NewIndex()
+	movies := search.NewIndexedMovieRepository(repository.NewMemoryMovieRepository(store), index)
+
+	server := grpc.NewServer()
+	pb.RegisterUserServiceServer(server, controllers.NewUserController(users, movies, keys, authz))
+	pb.RegisterCategoryServiceServer(server, controllers.NewCategoryController(categories, keys))
+	pb.RegisterMovieServiceServer(server, controllers.NewMovieController(movies, users, categories, keys, index, authz))
+
+	lis := bufconn.Listen(1 << 20)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return &testServer{
+		store:      store,
+		users:      pb.NewUserServiceClient(conn),
+		categories: pb.NewCategoryServiceClient(conn),
+		movies:     pb.NewMovieServiceClient(conn),
+	}
+}
+
+// createUsers streams reqs to CreateUsers and returns the response.
+func (s *testServer) createUsers(t *testing.T, reqs ...*pb.CreateUserRequest) *pb.CreateUsersResponse {
+	t.Helper()
+	stream, err := s.users.CreateUsers(context.Background())
+	if err != nil {
+		t.Fatalf("CreateUsers: %v", err)
+	}
+	for _, req := range reqs {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("send user: %v", err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CreateUsers: %v", err)
+	}
+	return resp
+}
+
+// createCategory creates one category and returns its id.
+func (s *testServer) createCategory(t *testing.T, name string) string {
+	t.Helper()
+	stream, err := s.categories.CreateCategories(context.Background())
+	if err != nil {
+		t.Fatalf("CreateCategories: %v", err)
+	}
+	if err := stream.Send(&pb.CreateCategoryRequest{Name: name, Description: name + " movies"}); err != nil {
+		t.Fatalf("send category: %v", err)
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CreateCategories: %v", err)
+	}
+	return resp.Ids[0]
+}
+
+// createMovies streams reqs to CreateMovies and returns the response.
+func (s *testServer) createMovies(t *testing.T, reqs ...*pb.CreateMovieRequest) *pb.CreateMoviesResponse {
+	t.Helper()
+	stream, err := s.movies.CreateMovies(context.Background())
+	if err != nil {
+		t.Fatalf("CreateMovies: %v", err)
+	}
+	for _, req := range reqs {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("send movie: %v", err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CreateMovies: %v", err)
+	}
+	return resp
+}
+
+func movieRequest(userID, categoryID, name string) *pb.CreateMovieRequest {
+	return &pb.CreateMovieRequest{
+		UserId:      userID,
+		CategoryId:  categoryID,
+		Name:        name,
+		BannerUrl:   "https://example.com/" + name + ".jpg",
+		MovieUrl:    "https://example.com/" + name + ".mp4",
+		Description: "The movie " + name,
+	}
+}
+
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != code {
+		t.Fatalf("got code %v (%v), want %v", got, err, code)
+	}
+}
@@ -2,31 +2,44 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/auth"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
 	"github.com/yaninyzwitty/movie-project-grpc/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type UserController struct {
-	session *gocql.Session
+	users  repository.UserRepository
+	movies repository.MovieRepository
+	keys   repository.IdempotencyRepository
+	// authz decides who may change or delete a user, the same write access
+	// as to the user's movies
+	authz auth.Authorizer
 	pb.UnimplementedUserServiceServer
 }
 
-func NewUserController(session *gocql.Session) *UserController {
+func NewUserController(users repository.UserRepository, movies repository.MovieRepository, keys repository.IdempotencyRepository, authz auth.Authorizer) *UserController {
 	return &UserController{
-		session: session,
+		users:  users,
+		movies: movies,
+		keys:   keys,
+		authz:  authz,
 	}
 }
 
 func (c *UserController) CreateUsers(stream pb.UserService_CreateUsersServer) error {
-	// Prepare the Cassandra statement
-	insertStmt := `INSERT INTO movie_db.users (id, name, alias_name) VALUES (?, ?, ?)`
-	batch := c.session.NewBatch(gocql.UnloggedBatch) // Use unlogged batch for efficiency
+	var batch []repository.User
+	var ids []string
+	seen := make(streamKeys[gocql.UUID])
 
 	totalUsersCreated := 0
+	totalUsersReplayed := 0
 
 	for {
 		req, err := stream.Recv()
@@ -38,60 +51,215 @@ func (c *UserController) CreateUsers(stream pb.UserService_CreateUsersServer) er
 		}
 
 		// Validate input
-		if req.Name == "" || req.AliasName == "" {
-			return status.Errorf(codes.InvalidArgument, "Name and AliasName are required")
+		if err := requireFields("name", req.Name, "alias_name", req.AliasName); err != nil {
+			return err
+		}
+		if err := validateIdempotencyKey(req.IdempotencyKey); err != nil {
+			return err
+		}
+
+		// A key repeated within the stream refers to a user of this stream
+		if userID, ok := seen.get("users", req.IdempotencyKey); ok {
+			ids = append(ids, userID.String())
+			totalUsersReplayed++
+			continue
 		}
 
-		// Generate a unique ID for the user
-		userID := gocql.TimeUUID()
+		// Generate a unique ID for the user, a retried request gets its original one
+		userID, replayed, err := claimKey(stream.Context(), c.keys, "users", req.IdempotencyKey, gocql.TimeUUID())
+		if err != nil {
+			return err
+		}
+		seen.put("users", req.IdempotencyKey, userID)
+		ids = append(ids, userID.String())
+
+		// Only write a replayed user again if the earlier attempt never stored it
+		if replayed {
+			_, err := c.users.GetUser(stream.Context(), userID)
+			if err == nil {
+				totalUsersReplayed++
+				continue
+			}
+			if err != repository.ErrNotFound {
+				return storageError(err, "failed to query user")
+			}
+		}
 
-		// Add the query to the batch
-		batch.Query(insertStmt, userID, req.Name, req.AliasName)
+		// Add the user to the batch
+		batch = append(batch, repository.User{ID: userID, Name: req.Name, AliasName: req.AliasName})
 		totalUsersCreated++
 
 		// Flush the batch if it exceeds a threshold (e.g., 100 queries)
-		if batch.Size() >= 100 {
-			if err := c.session.ExecuteBatch(batch); err != nil {
-				return status.Errorf(codes.Internal, "Error while inserting users: %v", err)
+		if len(batch) >= 100 {
+			if err := c.users.CreateUsers(stream.Context(), batch); err != nil {
+				return storageError(err, "Error while inserting users")
 			}
-			batch = c.session.NewBatch(gocql.UnloggedBatch) // Reset batch
+			batch = nil // Reset batch
 		}
 	}
 
 	// Insert any remaining users in the batch
-	if len(batch.Entries) > 0 {
-		if err := c.session.ExecuteBatch(batch); err != nil {
-			return status.Errorf(codes.Internal, "Error inserting batch: %v", err)
+	if len(batch) > 0 {
+		if err := c.users.CreateUsers(stream.Context(), batch); err != nil {
+			return storageError(err, "Error inserting batch")
 		}
 	}
 
 	// Send response to the client
 	return stream.SendAndClose(&pb.CreateUsersResponse{
-		Message:        "Users created successfully",
-		NoCreatedUsers: int32(totalUsersCreated),
+		Message:         "Users created successfully",
+		NoCreatedUsers:  int32(totalUsersCreated),
+		Ids:             ids,
+		NoReplayedUsers: int32(totalUsersReplayed),
 	})
 }
 
 func (c *UserController) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
-	userID, err := gocql.ParseUUID(req.Id)
+	userID, err := parseUUID("id", req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Error inserting batch: %v", err)
+		return nil, err
 	}
 
-	stmt := `SELECT name, alias_name FROM movie_db.users WHERE id = ?`
-	var name, aliasName string
-	if err := c.session.Query(stmt, userID).Scan(&name, &aliasName); err != nil {
-
-		if err == gocql.ErrNotFound {
-			return nil, status.Errorf(codes.Internal, "user with id %s not found: %v", userID, err)
+	user, err := c.users.GetUser(ctx, userID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "user with id %s not found", userID)
 		}
-		return nil, status.Errorf(codes.NotFound, "User not found")
+		return nil, storageError(err, "failed to query user")
 	}
 
 	return &pb.GetUserResponse{
-		Id:        userID.String(),
-		Name:      name,
-		AliasName: aliasName,
+		Id:        user.ID.String(),
+		Name:      user.Name,
+		AliasName: user.AliasName,
 	}, nil
 
 }
+
+func (c *UserController) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, invalidField("update_mask", "cannot be empty")
+	}
+
+	userID, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.authz.AuthorizeUser(ctx, userID, auth.Write); err != nil {
+		return nil, err
+	}
+
+	user, err := c.users.GetUser(ctx, userID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "user with id %s not found", userID)
+		}
+		return nil, storageError(err, "failed to query user")
+	}
+
+	// Apply only the fields named in the mask, every updated field must be set
+	for _, path := range req.UpdateMask.Paths {
+		var value string
+		switch path {
+		case "name":
+			value, user.Name = req.Name, req.Name
+		case "alias_name":
+			value, user.AliasName = req.AliasName, req.AliasName
+		default:
+			return nil, invalidField("update_mask", fmt.Sprintf("path %q is not supported", path))
+		}
+		if value == "" {
+			return nil, invalidField(path, "cannot be empty")
+		}
+	}
+
+	if err := c.users.UpdateUser(ctx, *user); err != nil {
+		return nil, storageError(err, "failed to update user")
+	}
+
+	return &pb.UpdateUserResponse{
+		User:    toUserResponse(*user),
+		Message: "User updated successfully",
+	}, nil
+}
+
+func (c *UserController) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	userID, err := parseUUID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.authz.AuthorizeUser(ctx, userID, auth.Write); err != nil {
+		return nil, err
+	}
+
+	if req.MoviesPolicy != pb.UserMoviesPolicy_USER_MOVIES_POLICY_DELETE && req.MoviesPolicy != pb.UserMoviesPolicy_USER_MOVIES_POLICY_ORPHAN {
+		return nil, invalidField("movies_policy", "must be DELETE or ORPHAN")
+	}
+
+	if _, err := c.users.GetUser(ctx, userID); err != nil {
+		if err == repository.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "user with id %s not found", userID)
+		}
+		return nil, storageError(err, "failed to query user")
+	}
+
+	// Handle the movies first so a failure leaves the user in place for a retry
+	deletedMovies := 0
+	switch req.MoviesPolicy {
+	case pb.UserMoviesPolicy_USER_MOVIES_POLICY_DELETE:
+		deletedMovies, err = c.movies.DeleteMoviesByUser(ctx, userID)
+		if err != nil {
+			return nil, storageError(err, "failed to delete movies")
+		}
+	case pb.UserMoviesPolicy_USER_MOVIES_POLICY_ORPHAN:
+		if err := c.movies.OrphanMoviesByUser(ctx, userID, time.Now()); err != nil {
+			return nil, storageError(err, "failed to orphan movies")
+		}
+	}
+
+	if err := c.users.DeleteUser(ctx, userID); err != nil {
+		return nil, storageError(err, "failed to delete user")
+	}
+
+	return &pb.DeleteUserResponse{
+		Message:         "User deleted successfully",
+		NoDeletedMovies: int32(deletedMovies),
+	}, nil
+}
+
+func (c *UserController) ListUsers(req *pb.ListUsersRequest, stream pb.UserService_ListUsersServer) error {
+	if req.PageSize <= 0 {
+		return invalidField("page_size", "must be greater than 0")
+	}
+
+	users, nextPagingState, err := c.users.ListUsers(stream.Context(), repository.Page{
+		Size:  int(req.PageSize),
+		State: req.PagingState,
+	})
+	if err != nil {
+		return storageError(err, "failed to query users")
+	}
+
+	responses := make([]*pb.GetUserResponse, 0, len(users))
+	for _, user := range users {
+		responses = append(responses, toUserResponse(user))
+	}
+
+	if err := stream.Send(&pb.ListUsersResponse{
+		Users:       responses,
+		Message:     "Users retrieved successfully",
+		PagingState: nextPagingState,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to send response: %v", err)
+	}
+
+	return nil
+}
+
+func toUserResponse(u repository.User) *pb.GetUserResponse {
+	return &pb.GetUserResponse{
+		Id:        u.ID.String(),
+		Name:      u.Name,
+		AliasName: u.AliasName,
+	}
+}
@@ -0,0 +1,290 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/auth"
+	"github.com/yaninyzwitty/movie-project-grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestCreateUsers(t *testing.T) {
+	s := newTestServer(t)
+
+	resp := s.createUsers(t,
+		&pb.CreateUserRequest{Name: "Ada", AliasName: "ada", IdempotencyKey: "import-1"},
+		&pb.CreateUserRequest{Name: "Grace", AliasName: "grace"},
+	)
+	if resp.NoCreatedUsers != 2 || resp.NoReplayedUsers != 0 || len(resp.Ids) != 2 {
+		t.Fatalf("got %d created, %d replayed, ids %v", resp.NoCreatedUsers, resp.NoReplayedUsers, resp.Ids)
+	}
+
+	// Retrying with the same key hands back the first user
+	retry := s.createUsers(t, &pb.CreateUserRequest{Name: "Ada", AliasName: "ada", IdempotencyKey: "import-1"})
+	if retry.NoCreatedUsers != 0 || retry.NoReplayedUsers != 1 || retry.Ids[0] != resp.Ids[0] {
+		t.Fatalf("retry got %d created, %d replayed, ids %v, want replay of %s", retry.NoCreatedUsers, retry.NoReplayedUsers, retry.Ids, resp.Ids[0])
+	}
+
+	user, err := s.users.GetUser(context.Background(), &pb.GetUserRequest{Id: resp.Ids[0]})
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if user.Name != "Ada" || user.AliasName != "ada" {
+		t.Errorf("got user %v", user)
+	}
+}
+
+func TestCreateUsersRepeatedKeyInStream(t *testing.T) {
+	s := newTestServer(t)
+
+	req := &pb.CreateUserRequest{Name: "Ada", AliasName: "ada", IdempotencyKey: "import-1"}
+	resp := s.createUsers(t, req, req)
+	if resp.NoCreatedUsers != 1 || resp.NoReplayedUsers != 1 {
+		t.Fatalf("got %d created, %d replayed, want 1 and 1", resp.NoCreatedUsers, resp.NoReplayedUsers)
+	}
+	if len(resp.Ids) != 2 || resp.Ids[0] != resp.Ids[1] {
+		t.Errorf("got ids %v, want the same id twice", resp.Ids)
+	}
+}
+
+func TestCreateUsersRejectsMissingFields(t *testing.T) {
+	s := newTestServer(t)
+
+	stream, err := s.users.CreateUsers(context.Background())
+	if err != nil {
+		t.Fatalf("CreateUsers: %v", err)
+	}
+	if err := stream.Send(&pb.CreateUserRequest{Name: "Ada"}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	_, err = stream.CloseAndRecv()
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestGetUser(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name string
+		id   string
+		code codes.Code
+	}{
+		{"malformed id", "not-a-uuid", codes.InvalidArgument},
+		{"unknown id", gocql.TimeUUID().String(), codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.users.GetUser(context.Background(), &pb.GetUserRequest{Id: tt.id})
+			wantCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	s := newTestServer(t)
+	id := s.createUsers(t, &pb.CreateUserRequest{Name: "Ada", AliasName: "ada"}).Ids[0]
+
+	tests := []struct {
+		name  string
+		req   *pb.UpdateUserRequest
+		code  codes.Code
+		alias string
+	}{
+		{
+			name: "empty mask",
+			req:  &pb.UpdateUserRequest{Id: id, AliasName: "countess"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unsupported path",
+			req:  &pb.UpdateUserRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "empty value",
+			req:  &pb.UpdateUserRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown user",
+			req:  &pb.UpdateUserRequest{Id: gocql.TimeUUID().String(), AliasName: "x", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"alias_name"}}},
+			code: codes.NotFound,
+		},
+		{
+			name:  "alias only",
+			req:   &pb.UpdateUserRequest{Id: id, Name: "ignored", AliasName: "countess", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"alias_name"}}},
+			code:  codes.OK,
+			alias: "countess",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.users.UpdateUser(context.Background(), tt.req)
+			wantCode(t, err, tt.code)
+			if err != nil {
+				return
+			}
+			if resp.User.Name != "Ada" || resp.User.AliasName != tt.alias {
+				t.Errorf("got user %v, want name Ada and alias %q", resp.User, tt.alias)
+			}
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     pb.UserMoviesPolicy
+		deleted    int32
+		moviesLeft int
+	}{
+		{"delete movies", pb.UserMoviesPolicy_USER_MOVIES_POLICY_DELETE, 2, 0},
+		{"orphan movies", pb.UserMoviesPolicy_USER_MOVIES_POLICY_ORPHAN, 0, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			userID := s.createUsers(t, &pb.CreateUserRequest{Name: "Ada", AliasName: "ada"}).Ids[0]
+			categoryID := s.createCategory(t, "Drama")
+			s.createMovies(t, movieRequest(userID, categoryID, "first"), movieRequest(userID, categoryID, "second"))
+
+			resp, err := s.users.DeleteUser(context.Background(), &pb.DeleteUserRequest{Id: userID, MoviesPolicy: tt.policy})
+			if err != nil {
+				t.Fatalf("DeleteUser: %v", err)
+			}
+			if resp.NoDeletedMovies != tt.deleted {
+				t.Errorf("got %d deleted movies, want %d", resp.NoDeletedMovies, tt.deleted)
+			}
+
+			_, err = s.users.GetUser(context.Background(), &pb.GetUserRequest{Id: userID})
+			wantCode(t, err, codes.NotFound)
+
+			movies, err := s.movies.GetMoviesByUserIDAndCategoryID(context.Background(), &pb.GetMoviesRequest{UserId: userID, CategoryId: categoryID})
+			if err != nil {
+				t.Fatalf("GetMoviesByUserIDAndCategoryID: %v", err)
+			}
+			left := 0
+			if resp, err := movies.Recv(); err == nil {
+				left = len(resp.Movies)
+			} else {
+				wantCode(t, err, codes.NotFound)
+			}
+			if left != tt.moviesLeft {
+				t.Errorf("got %d movies left, want %d", left, tt.moviesLeft)
+			}
+		})
+	}
+}
+
+func TestDeleteUserRequiresPolicy(t *testing.T) {
+	s := newTestServer(t)
+	id := s.createUsers(t, &pb.CreateUserRequest{Name: "Ada", AliasName: "ada"}).Ids[0]
+
+	_, err := s.users.DeleteUser(context.Background(), &pb.DeleteUserRequest{Id: id})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+// soleWriter lets every caller read any user but only write the user id.
+type soleWriter struct {
+	id gocql.UUID
+}
+
+func (a *soleWriter) AuthorizeUser(ctx context.Context, userID gocql.UUID, access auth.Access) error {
+	if access == auth.Write && userID != a.id {
+		return status.Errorf(codes.PermissionDenied, "cannot write user %s", userID)
+	}
+	return nil
+}
+
+func (a *soleWriter) AuthorizeAll(ctx context.Context, access auth.Access) error {
+	if access == auth.Write {
+		return status.Error(codes.PermissionDenied, "cannot write every user")
+	}
+	return nil
+}
+
+func (a *soleWriter) AuthorizeAdmin(ctx context.Context) error {
+	return status.Error(codes.PermissionDenied, "not an admin")
+}
+
+func TestUserWritesNeedWriteAccess(t *testing.T) {
+	authz := &soleWriter{}
+	s := newTestServerWith(t, authz)
+	ids := s.createUsers(t,
+		&pb.CreateUserRequest{Name: "Ada", AliasName: "ada"},
+		&pb.CreateUserRequest{Name: "Grace", AliasName: "grace"},
+	).Ids
+	writeAs := func(id string) {
+		var err error
+		if authz.id, err = gocql.ParseUUID(id); err != nil {
+			t.Fatalf("parse user id: %v", err)
+		}
+	}
+	victim, categoryID := ids[1], s.createCategory(t, "Drama")
+	writeAs(victim)
+	s.createMovies(t, movieRequest(victim, categoryID, "first"))
+
+	// Ada may write her own user but not Grace's
+	writeAs(ids[0])
+	_, err := s.users.UpdateUser(context.Background(), &pb.UpdateUserRequest{Id: victim, AliasName: "countess", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"alias_name"}}})
+	wantCode(t, err, codes.PermissionDenied)
+
+	// Deleting the movies along with the user must not get around the check
+	_, err = s.users.DeleteUser(context.Background(), &pb.DeleteUserRequest{Id: victim, MoviesPolicy: pb.UserMoviesPolicy_USER_MOVIES_POLICY_DELETE})
+	wantCode(t, err, codes.PermissionDenied)
+
+	user, err := s.users.GetUser(context.Background(), &pb.GetUserRequest{Id: victim})
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if user.AliasName != "grace" {
+		t.Errorf("got alias %q, want grace", user.AliasName)
+	}
+	if n := s.countByCategory(t, categoryID); n != 1 {
+		t.Errorf("got %d movies left, want 1", n)
+	}
+
+	if _, err := s.users.UpdateUser(context.Background(), &pb.UpdateUserRequest{Id: ids[0], AliasName: "countess", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"alias_name"}}}); err != nil {
+		t.Errorf("UpdateUser of the caller's own user: %v", err)
+	}
+}
+
+func TestListUsersPages(t *testing.T) {
+	s := newTestServer(t)
+	for range 5 {
+		s.createUsers(t, &pb.CreateUserRequest{Name: "user", AliasName: "user"})
+	}
+
+	seen := make(map[string]bool)
+	var state []byte
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("paging does not terminate")
+		}
+		stream, err := s.users.ListUsers(context.Background(), &pb.ListUsersRequest{PageSize: 2, PagingState: state})
+		if err != nil {
+			t.Fatalf("ListUsers: %v", err)
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("ListUsers: %v", err)
+		}
+		for _, u := range resp.Users {
+			if seen[u.Id] {
+				t.Fatalf("user %s listed twice", u.Id)
+			}
+			seen[u.Id] = true
+		}
+		if len(resp.PagingState) == 0 {
+			break
+		}
+		state = resp.PagingState
+	}
+	if len(seen) != 5 {
+		t.Errorf("listed %d users, want 5", len(seen))
+	}
+}
@@ -11,9 +11,12 @@ import (
 
 type AstraConfig struct {
 	Path     string
+	Keyspace string
 	Username string
 	Password string
 	Timeout  time.Duration
+	// Observer, when set, is told about every query and batch
+	Observer Observer
 }
 
 type AstraDb interface {
@@ -34,6 +37,9 @@ func (a *astradb) CreateDBConn(config *AstraConfig) (*gocql.Session, error) {
 		return nil, fmt.Errorf("failed to load bundle: %v", err)
 	}
 
+	cluster.Keyspace = config.Keyspace
+	observe(cluster, config.Observer)
+
 	session, err := gocql.NewSession(*cluster)
 	if err != nil {
 		slog.Error("Failed to create session", "error", err)
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+type CassandraConfig struct {
+	Hosts       []string
+	Port        int
+	Keyspace    string
+	Username    string
+	Password    string
+	Consistency string
+	LocalDC     string
+	// TLS is only enabled when at least one of the paths is set
+	CertPath               string
+	KeyPath                string
+	CAPath                 string
+	EnableHostVerification bool
+	Timeout                time.Duration
+	// Observer, when set, is told about every query and batch
+	Observer Observer
+}
+
+type CassandraDb interface {
+	CreateDBConn(config *CassandraConfig) (*gocql.Session, error)
+}
+
+type cassandradb struct{}
+
+func NewCassandraDb() CassandraDb {
+	return &cassandradb{}
+}
+
+func (c *cassandradb) CreateDBConn(config *CassandraConfig) (*gocql.Session, error) {
+	if len(config.Hosts) == 0 {
+		return nil, fmt.Errorf("at least one cassandra host is required")
+	}
+
+	// initialize cluster
+	cluster := gocql.NewCluster(config.Hosts...)
+	if config.Port != 0 {
+		cluster.Port = config.Port
+	}
+	cluster.Keyspace = config.Keyspace
+	if config.Timeout != 0 {
+		cluster.Timeout = config.Timeout
+		cluster.ConnectTimeout = config.Timeout
+	}
+
+	cluster.Consistency = gocql.LocalQuorum
+	if config.Consistency != "" {
+		consistency, err := gocql.ParseConsistencyWrapper(config.Consistency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid consistency level: %v", err)
+		}
+		cluster.Consistency = consistency
+	}
+
+	if config.LocalDC != "" {
+		cluster.PoolConfig.HostSelectionPolicy = gocql.TokenAwareHostPolicy(gocql.DCAwareRoundRobinPolicy(config.LocalDC))
+	}
+
+	if config.Username != "" {
+		cluster.Authenticator = gocql.PasswordAuthenticator{
+			Username: config.Username,
+			Password: config.Password,
+		}
+	}
+
+	if config.CertPath != "" || config.KeyPath != "" || config.CAPath != "" {
+		cluster.SslOpts = &gocql.SslOptions{
+			CertPath:               config.CertPath,
+			KeyPath:                config.KeyPath,
+			CaPath:                 config.CAPath,
+			EnableHostVerification: config.EnableHostVerification,
+		}
+	}
+
+	observe(cluster, config.Observer)
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		slog.Error("Failed to create session", "error", err)
+		return nil, fmt.Errorf("failed to create session: %v", err)
+	}
+	slog.Info("Connected to Cassandra", "hosts", config.Hosts)
+
+	return session, nil
+}
@@ -0,0 +1,119 @@
+package migrations
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/database"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+)
+
+// dataMigrations backfill the lookup tables added after rows already
+// existed. They can run while the server is serving, whatever the server
+// writes meanwhile wins: movie copies carry the write time of the row they
+// were copied from and names are claimed with IF NOT EXISTS. Running one
+// again after a failure is safe.
+var dataMigrations = []Migration{
+	{Version: 9, Name: "backfill_movies_by_id", Run: backfillMoviesByID},
+	{Version: 10, Name: "backfill_category_names", Run: backfillCategoryNames},
+	{Version: 11, Name: "backfill_movies_by_category", Run: backfillMoviesByCategory},
+}
+
+// backfillPageSize is how many rows a backfill reads per page.
+const backfillPageSize = 500
+
+// backfillMoviesByID adds the movies_by_id entry of every movie written
+// before 0002_movies_by_id.
+func backfillMoviesByID(ctx context.Context, session *gocql.Session) error {
+	stmt := `INSERT INTO movies_by_id (movie_id, user_id, category_id, created_at) VALUES (?, ?, ?, ?) USING TIMESTAMP ?`
+	n, err := eachMovie(ctx, session, func(m repository.Movie, writeTime int64) error {
+		return session.Query(stmt, m.MovieID, m.UserID, m.CategoryID, m.CreatedAt, writeTime).WithContext(ctx).Exec()
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("Backfilled movies_by_id", "movies", n)
+	return nil
+}
+
+// backfillMoviesByCategory copies every movie written before
+// 0006_movies_by_category into its category bucket. The search engines read
+// that table, they miss older movies until this ran.
+func backfillMoviesByCategory(ctx context.Context, session *gocql.Session) error {
+	stmt := `INSERT INTO movies_by_category (category_id, bucket, created_at, movie_id, user_id, name, banner_url, movie_url, description, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) USING TIMESTAMP ?`
+	n, err := eachMovie(ctx, session, func(m repository.Movie, writeTime int64) error {
+		return session.Query(stmt, m.CategoryID, repository.CategoryBucket(m.MovieID), m.CreatedAt, m.MovieID, m.UserID, m.Name, m.BannerURL, m.MovieURL, m.Description, m.UpdatedAt, writeTime).WithContext(ctx).Exec()
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("Backfilled movies_by_category", "movies", n)
+	return nil
+}
+
+// backfillCategoryNames claims the name of every category created before
+// 0005_categories_by_name. When names collide the oldest category keeps the
+// name and the others are logged, they have to be renamed by hand.
+func backfillCategoryNames(ctx context.Context, session *gocql.Session) error {
+	categories := repository.NewCassandraCategoryRepository(database.StaticSession(session))
+
+	var all []repository.Category
+	page := repository.Page{Size: backfillPageSize}
+	for {
+		rows, next, err := categories.ListCategories(ctx, page)
+		if err != nil {
+			return err
+		}
+		all = append(all, rows...)
+		if len(next) == 0 {
+			break
+		}
+		page.State = next
+	}
+	// Category ids are time based, so this puts the oldest first
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].ID.Timestamp() < all[j].ID.Timestamp()
+	})
+
+	duplicates := 0
+	for _, c := range all {
+		holder, err := categories.ClaimCategoryName(ctx, c.Name, c.ID)
+		if err != nil {
+			return err
+		}
+		if holder != c.ID {
+			slog.Warn("Category name is already held by another category", "name", c.Name, "id", c.ID, "holder", holder)
+			duplicates++
+		}
+	}
+	slog.Info("Backfilled categories_by_name", "categories", len(all), "duplicates", duplicates)
+	return nil
+}
+
+// eachMovie calls fn with every row of movies_by_user and the time, in
+// microseconds, its columns were last written. It returns how many rows it
+// visited.
+func eachMovie(ctx context.Context, session *gocql.Session, fn func(m repository.Movie, writeTime int64) error) (int, error) {
+	stmt := `SELECT user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at, WRITETIME(updated_at) FROM movies_by_user`
+	iter := session.Query(stmt).WithContext(ctx).PageSize(backfillPageSize).Iter()
+
+	var (
+		m         repository.Movie
+		writeTime int64
+		n         int
+	)
+	for iter.Scan(&m.UserID, &m.MovieID, &m.CategoryID, &m.Name, &m.BannerURL, &m.MovieURL, &m.Description, &m.CreatedAt, &m.UpdatedAt, &writeTime) {
+		// A partition holding only the orphaned_at static column has no movie
+		if m.MovieID == (gocql.UUID{}) {
+			continue
+		}
+		if err := fn(m, writeTime); err != nil {
+			iter.Close()
+			return n, err
+		}
+		n++
+	}
+	return n, iter.Close()
+}
@@ -0,0 +1,316 @@
+// Package migrations applies the versioned CQL migrations embedded in the
+// server binary and records which ones ran in the schema_migrations table.
+//
+// A migration is a pair of files named <version>_<name>.up.cql and
+// <version>_<name>.down.cql, each holding one or more statements separated
+// by semicolons. Data migrations, which copy existing rows into tables added
+// by earlier migrations, are written in Go instead, see dataMigrations.
+package migrations
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+//go:embed *.cql
+var files embed.FS
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.cql$`)
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      []string
+	Down    []string
+	// Run is set on data migrations and applies them instead of Up. Reverting
+	// a data migration only removes its record, the rows it copied go away
+	// with the tables that hold them.
+	Run func(ctx context.Context, session *gocql.Session) error
+}
+
+// Status reports whether a migration has been applied. Migrations recorded in
+// the database that this binary does not know have empty Up and Down and no
+// Run.
+type Status struct {
+	Migration
+	// AppliedAt is nil while the migration is pending
+	AppliedAt *time.Time
+}
+
+type Migrator interface {
+	// Up applies every pending migration in version order and returns them.
+	Up(ctx context.Context) ([]Migration, error)
+	// Down reverts the most recently applied migration, it returns nil when
+	// there is nothing to revert.
+	Down(ctx context.Context) (*Migration, error)
+	Status(ctx context.Context) ([]Status, error)
+	// Pending returns the migrations that have not been applied yet.
+	Pending(ctx context.Context) ([]Migration, error)
+}
+
+type cassandraMigrator struct {
+	session    *gocql.Session
+	migrations []Migration
+}
+
+func NewMigrator(session *gocql.Session) (Migrator, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return &cassandraMigrator{session: session, migrations: migrations}, nil
+}
+
+// Load reads the embedded migrations, adds the data migrations and sorts
+// them by version.
+func Load() ([]Migration, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migrations: unexpected file %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migrations: version %d is used by %s and %s", version, m.Name, match[2])
+		}
+
+		data, err := files.ReadFile(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if match[3] == "up" {
+			m.Up = splitStatements(string(data))
+		} else {
+			m.Down = splitStatements(string(data))
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if len(m.Up) == 0 || len(m.Down) == 0 {
+			return nil, fmt.Errorf("migrations: %04d_%s needs both an up and a down file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	for _, m := range dataMigrations {
+		if other, ok := byVersion[m.Version]; ok {
+			return nil, fmt.Errorf("migrations: version %d is used by %s and %s", m.Version, other.Name, m.Name)
+		}
+		byVersion[m.Version] = &m
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+func (r *cassandraMigrator) Up(ctx context.Context) ([]Migration, error) {
+	if err := r.createTable(ctx); err != nil {
+		return nil, err
+	}
+	pending, err := r.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range pending {
+		if m.Run != nil {
+			if err := m.Run(ctx, r.session); err != nil {
+				return pending[:i], fmt.Errorf("migrations: %04d_%s: %w", m.Version, m.Name, err)
+			}
+		} else if err := r.exec(ctx, m, m.Up); err != nil {
+			return pending[:i], err
+		}
+		stmt := `INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`
+		if err := r.session.Query(stmt, m.Version, m.Name, time.Now()).WithContext(ctx).Exec(); err != nil {
+			return pending[:i], fmt.Errorf("migrations: failed to record %04d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+	return pending, nil
+}
+
+func (r *cassandraMigrator) Down(ctx context.Context) (*Migration, error) {
+	statuses, err := r.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var last *Status
+	for i := range statuses {
+		if statuses[i].AppliedAt != nil {
+			last = &statuses[i]
+		}
+	}
+	if last == nil {
+		return nil, nil
+	}
+	if len(last.Down) == 0 && last.Run == nil {
+		return nil, fmt.Errorf("migrations: %04d_%s is not known to this binary and cannot be reverted", last.Version, last.Name)
+	}
+
+	if err := r.exec(ctx, last.Migration, last.Down); err != nil {
+		return nil, err
+	}
+	stmt := `DELETE FROM schema_migrations WHERE version = ?`
+	if err := r.session.Query(stmt, last.Version).WithContext(ctx).Exec(); err != nil {
+		return nil, fmt.Errorf("migrations: failed to record revert of %04d_%s: %w", last.Version, last.Name, err)
+	}
+	return &last.Migration, nil
+}
+
+func (r *cassandraMigrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(r.migrations))
+	for _, m := range r.migrations {
+		s := Status{Migration: m}
+		if row, ok := applied[m.Version]; ok {
+			s.AppliedAt = row.AppliedAt
+			delete(applied, m.Version)
+		}
+		statuses = append(statuses, s)
+	}
+	// Anything left was applied by a newer binary
+	for _, row := range applied {
+		statuses = append(statuses, row)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+	return statuses, nil
+}
+
+func (r *cassandraMigrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, m := range r.migrations {
+		if _, ok := applied[m.Version]; !ok {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// createTable creates schema_migrations unless it exists.
+func (r *cassandraMigrator) createTable(ctx context.Context) error {
+	createStmt := `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INT PRIMARY KEY,
+		name TEXT,
+		applied_at TIMESTAMP
+	)`
+	if err := r.session.Query(createStmt).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("migrations: failed to create schema_migrations: %w", err)
+	}
+	return r.session.AwaitSchemaAgreement(ctx)
+}
+
+// applied reads schema_migrations. Before the first Up the table does not
+// exist and nothing has been applied, it is not created here so checking the
+// schema never changes it.
+func (r *cassandraMigrator) applied(ctx context.Context) (map[int]Status, error) {
+	applied := make(map[int]Status)
+
+	// The table lives in the keyspace the session uses
+	lookup := r.session.Query(`SELECT table_name FROM system_schema.tables WHERE keyspace_name = ? AND table_name = 'schema_migrations'`)
+	var table string
+	if err := lookup.Bind(lookup.Keyspace()).WithContext(ctx).Scan(&table); err != nil {
+		if err == gocql.ErrNotFound {
+			return applied, nil
+		}
+		return nil, fmt.Errorf("migrations: failed to look up schema_migrations: %w", err)
+	}
+
+	iter := r.session.Query(`SELECT version, name, applied_at FROM schema_migrations`).WithContext(ctx).Iter()
+	var (
+		version   int
+		name      string
+		appliedAt time.Time
+	)
+	for iter.Scan(&version, &name, &appliedAt) {
+		at := appliedAt
+		applied[version] = Status{Migration: Migration{Version: version, Name: name}, AppliedAt: &at}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
+
+// exec runs the statements of one direction of a migration, waiting for the
+// cluster to agree on the schema after each of them.
+func (r *cassandraMigrator) exec(ctx context.Context, m Migration, statements []string) error {
+	for i, stmt := range statements {
+		if err := r.session.Query(stmt).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("migrations: %04d_%s statement %d: %w", m.Version, m.Name, i+1, err)
+		}
+		if err := r.session.AwaitSchemaAgreement(ctx); err != nil {
+			return fmt.Errorf("migrations: %04d_%s statement %d: %w", m.Version, m.Name, i+1, err)
+		}
+	}
+	return nil
+}
+
+// splitStatements splits a CQL script on the semicolons outside of string
+// literals and drops -- comments.
+func splitStatements(script string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+		quoted     bool
+	)
+	flush := func() {
+		if stmt := strings.TrimSpace(current.String()); stmt != "" {
+			statements = append(statements, stmt)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case c == '\'':
+			quoted = !quoted
+		case !quoted && c == '-' && strings.HasPrefix(script[i:], "--"):
+			// Skip to the end of the line
+			for i < len(script) && script[i] != '\n' {
+				i++
+			}
+			current.WriteByte('\n')
+			continue
+		case !quoted && c == ';':
+			flush()
+			continue
+		}
+		current.WriteByte(c)
+	}
+	flush()
+	return statements
+}
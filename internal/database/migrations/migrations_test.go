@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			t.Fatalf("migration %d has version %d, versions must follow each other", i, m.Version)
+		}
+		if m.Run == nil && (len(m.Up) == 0 || len(m.Down) == 0) {
+			t.Errorf("%04d_%s has no statements", m.Version, m.Name)
+		}
+		if m.Run != nil && (len(m.Up) > 0 || len(m.Down) > 0) {
+			t.Errorf("data migration %04d_%s also has CQL files", m.Version, m.Name)
+		}
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	script := `-- a comment; with a semicolon
+CREATE TABLE t (id INT PRIMARY KEY);
+INSERT INTO t (id, name) VALUES (1, 'a;b'); -- trailing
+
+`
+	want := []string{
+		"CREATE TABLE t (id INT PRIMARY KEY)",
+		"INSERT INTO t (id, name) VALUES (1, 'a;b')",
+	}
+	if got := splitStatements(script); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+)
+
+// Observer follows the queries and batches a session runs, such as a tracer.
+// An Observer that also implements gocql.ConnectObserver or
+// gocql.StreamObserver is told about the pool's connections and the requests
+// written to them as well.
+type Observer interface {
+	gocql.QueryObserver
+	gocql.BatchObserver
+}
+
+// observe installs o, which may be nil, on cluster.
+func observe(cluster *gocql.ClusterConfig, o Observer) {
+	if o == nil {
+		return
+	}
+	cluster.QueryObserver = o
+	cluster.BatchObserver = o
+	if c, ok := o.(gocql.ConnectObserver); ok {
+		cluster.ConnectObserver = c
+	}
+	if s, ok := o.(gocql.StreamObserver); ok {
+		cluster.StreamObserver = s
+	}
+}
+
+// Observers returns an Observer that passes everything on to each of
+// observers in turn.
+func Observers(observers ...Observer) Observer {
+	return multiObserver(observers)
+}
+
+type multiObserver []Observer
+
+func (m multiObserver) ObserveQuery(ctx context.Context, q gocql.ObservedQuery) {
+	for _, o := range m {
+		o.ObserveQuery(ctx, q)
+	}
+}
+
+func (m multiObserver) ObserveBatch(ctx context.Context, b gocql.ObservedBatch) {
+	for _, o := range m {
+		o.ObserveBatch(ctx, b)
+	}
+}
+
+func (m multiObserver) ObserveConnect(c gocql.ObservedConnect) {
+	for _, o := range m {
+		if connect, ok := o.(gocql.ConnectObserver); ok {
+			connect.ObserveConnect(c)
+		}
+	}
+}
+
+func (m multiObserver) StreamContext(ctx context.Context) gocql.StreamObserverContext {
+	var streams multiStreamContext
+	for _, o := range m {
+		if observer, ok := o.(gocql.StreamObserver); ok {
+			if s := observer.StreamContext(ctx); s != nil {
+				streams = append(streams, s)
+			}
+		}
+	}
+	if len(streams) == 0 {
+		return nil
+	}
+	return streams
+}
+
+type multiStreamContext []gocql.StreamObserverContext
+
+func (m multiStreamContext) StreamStarted(s gocql.ObservedStream) {
+	for _, c := range m {
+		c.StreamStarted(s)
+	}
+}
+
+func (m multiStreamContext) StreamAbandoned(s gocql.ObservedStream) {
+	for _, c := range m {
+		c.StreamAbandoned(s)
+	}
+}
+
+func (m multiStreamContext) StreamFinished(s gocql.ObservedStream) {
+	for _, c := range m {
+		c.StreamFinished(s)
+	}
+}
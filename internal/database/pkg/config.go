@@ -1,26 +1,254 @@
 package pkg
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"log/slog"
+	"strings"
 	"time"
 
+	"github.com/yaninyzwitty/movie-project-grpc/internal/secrets"
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	Server   Server `yaml:"server"`
-	Database DB     `yaml:"database"`
+	Server   Server  `yaml:"server"`
+	Database DB      `yaml:"database"`
+	Search   Search  `yaml:"search"`
+	Auth     Auth    `yaml:"auth"`
+	Client   Client  `yaml:"client"`
+	Tracing  Tracing `yaml:"tracing"`
+	Metrics  Metrics `yaml:"metrics"`
 }
 
 type Server struct {
 	Port    int           `yaml:"port"`
 	Timeout time.Duration `yaml:"timeout"`
+	TLS     ServerTLS     `yaml:"tls"`
+}
+
+type ServerTLS struct {
+	// CertPath and KeyPath turn on TLS, the server speaks plaintext without them
+	CertPath string `yaml:"cert_path"`
+	KeyPath  string `yaml:"key_path"`
+	// ClientCAPath requires every client to present a certificate signed by
+	// one of the CAs in this bundle
+	ClientCAPath string `yaml:"client_ca_path"`
+	// ReloadInterval is how often the files are checked for changes
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+// Client configures cmd/client.
+type Client struct {
+	TLS ClientTLS `yaml:"tls"`
+}
+
+type ClientTLS struct {
+	Enabled bool `yaml:"enabled"`
+	// CAPath verifies the server instead of the system roots
+	CAPath string `yaml:"ca_path"`
+	// CertPath and KeyPath are presented to servers that require a client
+	// certificate
+	CertPath   string `yaml:"cert_path"`
+	KeyPath    string `yaml:"key_path"`
+	ServerName string `yaml:"server_name"`
 }
 
 type DB struct {
+	// Driver selects the storage backend: "astra" (default), "cassandra" or "memory".
+	Driver   string `yaml:"driver"`
 	Path     string `yaml:"path"`
 	Username string `yaml:"username"`
+	// Password is never read from the file, see Load
+	Password string `yaml:"-"`
+	// PasswordSource reads the password from "env:NAME", "file:PATH" or
+	// "command:LINE" instead. It is read again every PasswordRefresh and the
+	// database session is reopened when it changed.
+	PasswordSource  string        `yaml:"password_source"`
+	PasswordRefresh time.Duration `yaml:"password_refresh"`
+	// Migrations decides what happens at startup when embedded schema
+	// migrations are pending: "ignore" (default) serves anyway, "check"
+	// refuses to serve and "auto" applies them first.
+	Migrations string `yaml:"migrations"`
+	// Keyspace holds the tables of the astra and cassandra drivers, every
+	// statement is sent unqualified
+	Keyspace string `yaml:"keyspace"`
+	// The fields below are only used by the cassandra driver
+	Hosts       []string     `yaml:"hosts"`
+	Port        int          `yaml:"port"`
+	Consistency string       `yaml:"consistency"`
+	LocalDC     string       `yaml:"local_dc"`
+	TLS         CassandraTLS `yaml:"tls"`
+}
+
+type Search struct {
+	// Driver selects the search engine: "embedded" (default), an in-process
+	// inverted index, or "sai", Astra's analyzed indexes. sai needs the astra
+	// or cassandra database driver.
+	Driver string `yaml:"driver"`
+}
+
+type Auth struct {
+	// Disabled lets every call through unauthenticated, only meant for local
+	// development
+	Disabled bool `yaml:"disabled"`
+	// JWKSPath is the JSON Web Key Set holding the HS256 and RS256 keys
+	// tokens are verified with
+	JWKSPath string `yaml:"jwks_path"`
+	// JWKSRefresh is how often the JWKS file is checked for rotated keys
+	JWKSRefresh time.Duration `yaml:"jwks_refresh"`
+	// Issuer and Audience, when set, have to match the iss and aud claims
+	Issuer   string        `yaml:"issuer"`
+	Audience string        `yaml:"audience"`
+	Leeway   time.Duration `yaml:"leeway"`
+	// AllowUnauthenticated lists the methods callable without a token, as
+	// "/moviebase.UserService/GetUser" or "/moviebase.UserService/*"
+	AllowUnauthenticated []string `yaml:"allow_unauthenticated"`
+	// APIKeyCacheTTL is how long a looked up API key is remembered, so how
+	// long a key revoked on another instance keeps working. 0 turns the
+	// cache off.
+	APIKeyCacheTTL time.Duration `yaml:"api_key_cache_ttl"`
+	// A subject that is a user ID owns that user's movies. Admins may also
+	// read and write the movies of every user, service accounts may read them.
+	Admins          []string `yaml:"admins"`
+	ServiceAccounts []string `yaml:"service_accounts"`
+}
+
+type Tracing struct {
+	// Exporter sends spans nowhere ("none", default), to standard output
+	// ("stdout", for local runs) or to an OTLP gRPC receiver ("otlp")
+	Exporter string `yaml:"exporter"`
+	// Endpoint is the host:port of the OTLP receiver, the
+	// OTEL_EXPORTER_OTLP_ENDPOINT variable or localhost:4317 when empty
+	Endpoint string `yaml:"endpoint"`
+	Insecure bool   `yaml:"insecure"`
+	// SampleRatio is the share of traces started here that are recorded,
+	// calls from a sampled parent are always recorded
+	SampleRatio float64 `yaml:"sample_ratio"`
+	ServiceName string  `yaml:"service_name"`
+}
+
+type Metrics struct {
+	// Port serves Prometheus metrics on http://:<port>/metrics, 0 turns the
+	// listener off
+	Port int `yaml:"port"`
+}
+
+type CassandraTLS struct {
+	CertPath               string `yaml:"cert_path"`
+	KeyPath                string `yaml:"key_path"`
+	CAPath                 string `yaml:"ca_path"`
+	EnableHostVerification bool   `yaml:"enable_host_verification"`
+}
+
+// Validate reports every setting that would keep the server from starting.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("server.port must be between 1 and 65535, got %d", c.Server.Port))
+	}
+	if c.Server.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("server.timeout must be greater than 0, got %s", c.Server.Timeout))
+	}
+	if (c.Server.TLS.CertPath == "") != (c.Server.TLS.KeyPath == "") {
+		errs = append(errs, errors.New("server.tls.cert_path and server.tls.key_path must be set together"))
+	}
+	if c.Server.TLS.ClientCAPath != "" && c.Server.TLS.CertPath == "" {
+		errs = append(errs, errors.New("server.tls.client_ca_path needs server.tls.cert_path and server.tls.key_path"))
+	}
+	if c.Server.TLS.CertPath != "" && c.Server.TLS.ReloadInterval <= 0 {
+		errs = append(errs, fmt.Errorf("server.tls.reload_interval must be greater than 0, got %s", c.Server.TLS.ReloadInterval))
+	}
+
+	switch c.Database.Driver {
+	case "", "astra":
+		if c.Database.Path == "" {
+			errs = append(errs, errors.New("database.path is required for the astra driver"))
+		}
+		if c.Database.Password == "" && c.Database.PasswordSource == "" {
+			errs = append(errs, errors.New("the astra driver needs a password, set ASTRA_DB_PASSWORD, MOVIE_DATABASE_PASSWORD or database.password_source"))
+		}
+	case "cassandra":
+		if len(c.Database.Hosts) == 0 {
+			errs = append(errs, errors.New("database.hosts is required for the cassandra driver"))
+		}
+		if c.Database.Port < 0 || c.Database.Port > 65535 {
+			errs = append(errs, fmt.Errorf("database.port must be between 1 and 65535, got %d", c.Database.Port))
+		}
+		if c.Database.Username != "" && c.Database.Password == "" && c.Database.PasswordSource == "" {
+			errs = append(errs, errors.New("database.username is set but there is no password, set CASSANDRA_PASSWORD, MOVIE_DATABASE_PASSWORD or database.password_source"))
+		}
+	case "memory":
+	default:
+		errs = append(errs, fmt.Errorf("unknown database.driver %q", c.Database.Driver))
+	}
+	if c.Database.Driver != "memory" && c.Database.Keyspace == "" {
+		errs = append(errs, errors.New("database.keyspace is required for the astra and cassandra drivers"))
+	}
+
+	if c.Database.PasswordSource != "" {
+		if _, err := secrets.Parse(c.Database.PasswordSource); err != nil {
+			errs = append(errs, fmt.Errorf("database.password_source: %w", err))
+		}
+		if c.Database.PasswordRefresh <= 0 {
+			errs = append(errs, fmt.Errorf("database.password_refresh must be greater than 0, got %s", c.Database.PasswordRefresh))
+		}
+	}
+
+	switch c.Database.Migrations {
+	case "", "ignore", "check", "auto":
+	default:
+		errs = append(errs, fmt.Errorf("unknown database.migrations mode %q", c.Database.Migrations))
+	}
+
+	switch c.Search.Driver {
+	case "", "embedded":
+	case "sai":
+		if c.Database.Driver == "memory" {
+			errs = append(errs, errors.New("search.driver sai needs the astra or cassandra database driver"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unknown search.driver %q", c.Search.Driver))
+	}
+
+	if !c.Auth.Disabled {
+		if c.Auth.JWKSPath == "" {
+			errs = append(errs, errors.New("auth.jwks_path is required unless auth.disabled is set"))
+		}
+		if c.Auth.JWKSRefresh <= 0 {
+			errs = append(errs, fmt.Errorf("auth.jwks_refresh must be greater than 0, got %s", c.Auth.JWKSRefresh))
+		}
+		if c.Auth.APIKeyCacheTTL < 0 {
+			errs = append(errs, fmt.Errorf("auth.api_key_cache_ttl must not be negative, got %s", c.Auth.APIKeyCacheTTL))
+		}
+		if c.Auth.Leeway < 0 {
+			errs = append(errs, fmt.Errorf("auth.leeway must not be negative, got %s", c.Auth.Leeway))
+		}
+		for _, method := range c.Auth.AllowUnauthenticated {
+			if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+				errs = append(errs, fmt.Errorf("auth.allow_unauthenticated entry %q is not of the form /package.Service/Method", method))
+			}
+		}
+	}
+
+	switch c.Tracing.Exporter {
+	case "", "none", "stdout", "otlp":
+	default:
+		errs = append(errs, fmt.Errorf("unknown tracing.exporter %q", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio must be between 0 and 1, got %g", c.Tracing.SampleRatio))
+	}
+
+	if c.Metrics.Port < 0 || c.Metrics.Port > 65535 {
+		errs = append(errs, fmt.Errorf("metrics.port must be between 0 and 65535, got %d", c.Metrics.Port))
+	}
+	if c.Metrics.Port != 0 && c.Metrics.Port == c.Server.Port {
+		errs = append(errs, fmt.Errorf("metrics.port and server.port must differ, both are %d", c.Server.Port))
+	}
+
+	return errors.Join(errs...)
 }
 
 func (c *Config) LoadFile(file io.Reader) error {
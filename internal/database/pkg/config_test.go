@@ -0,0 +1,86 @@
+package pkg
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(c *Config)
+		err    string
+	}{
+		{"memory", func(c *Config) {}, ""},
+		{"astra", func(c *Config) {
+			c.Database.Driver = "astra"
+			c.Database.Password = "secret"
+		}, ""},
+		{"astra without password", func(c *Config) {
+			c.Database.Driver = "astra"
+		}, "needs a password"},
+		{"astra password source", func(c *Config) {
+			c.Database.Driver = "astra"
+			c.Database.PasswordSource = "env:SECRET"
+		}, ""},
+		{"astra without keyspace", func(c *Config) {
+			c.Database.Driver = "astra"
+			c.Database.Password = "secret"
+			c.Database.Keyspace = ""
+		}, "database.keyspace"},
+		{"cassandra", func(c *Config) {
+			c.Database.Driver = "cassandra"
+			c.Database.Hosts = []string{"localhost"}
+			c.Database.Username = ""
+		}, ""},
+		{"cassandra without hosts", func(c *Config) {
+			c.Database.Driver = "cassandra"
+			c.Database.Username = ""
+		}, "database.hosts"},
+		{"cassandra username without password", func(c *Config) {
+			c.Database.Driver = "cassandra"
+			c.Database.Hosts = []string{"localhost"}
+		}, "no password"},
+		{"unknown driver", func(c *Config) { c.Database.Driver = "sqlite" }, "database.driver"},
+		{"port", func(c *Config) { c.Server.Port = 0 }, "server.port"},
+		{"timeout", func(c *Config) { c.Server.Timeout = 0 }, "server.timeout"},
+		{"tls cert without key", func(c *Config) { c.Server.TLS.CertPath = "cert.pem" }, "set together"},
+		{"client ca without cert", func(c *Config) { c.Server.TLS.ClientCAPath = "ca.pem" }, "client_ca_path"},
+		{"password source", func(c *Config) { c.Database.PasswordSource = "carrier-pigeon" }, "password_source"},
+		{"migrations", func(c *Config) { c.Database.Migrations = "sometimes" }, "database.migrations"},
+		{"sai on memory", func(c *Config) { c.Search.Driver = "sai" }, "search.driver sai"},
+		{"unknown search driver", func(c *Config) { c.Search.Driver = "grep" }, "search.driver"},
+		{"auth without jwks", func(c *Config) { c.Auth.JWKSPath = "" }, "auth.jwks_path"},
+		{"auth disabled without jwks", func(c *Config) {
+			c.Auth.Disabled = true
+			c.Auth.JWKSPath = ""
+		}, ""},
+		{"jwks refresh", func(c *Config) { c.Auth.JWKSRefresh = 0 }, "auth.jwks_refresh"},
+		{"api key cache ttl", func(c *Config) { c.Auth.APIKeyCacheTTL = -time.Second }, "auth.api_key_cache_ttl"},
+		{"api key cache off", func(c *Config) { c.Auth.APIKeyCacheTTL = 0 }, ""},
+		{"negative leeway", func(c *Config) { c.Auth.Leeway = -time.Second }, "auth.leeway"},
+		{"unauthenticated method", func(c *Config) { c.Auth.AllowUnauthenticated = []string{"GetMovie"} }, "allow_unauthenticated"},
+		{"tracing exporter", func(c *Config) { c.Tracing.Exporter = "zipkin" }, "tracing.exporter"},
+		{"sample ratio", func(c *Config) { c.Tracing.SampleRatio = 2 }, "tracing.sample_ratio"},
+		{"metrics port", func(c *Config) { c.Metrics.Port = 70000 }, "metrics.port"},
+		{"metrics on server port", func(c *Config) { c.Metrics.Port = c.Server.Port }, "must differ"},
+		{"metrics off", func(c *Config) { c.Metrics.Port = 0 }, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Defaults()
+			c.Database.Driver = "memory"
+			tt.change(&c)
+			err := c.Validate()
+			switch {
+			case tt.err == "" && err != nil:
+				t.Errorf("Validate: %v", err)
+			case tt.err != "" && err == nil:
+				t.Errorf("Validate succeeded, want an error about %s", tt.err)
+			case tt.err != "" && !strings.Contains(err.Error(), tt.err):
+				t.Errorf("got error %q, want one about %s", err, tt.err)
+			}
+		})
+	}
+}
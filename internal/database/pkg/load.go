@@ -0,0 +1,238 @@
+package pkg
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Defaults returns the configuration used for every setting that neither the
+// file, the environment nor a flag sets.
+func Defaults() Config {
+	return Config{
+		Server: Server{
+			Port:    50051,
+			Timeout: 20 * time.Second,
+			TLS: ServerTLS{
+				ReloadInterval: 30 * time.Second,
+			},
+		},
+		Database: DB{
+			Driver:          "astra",
+			Path:            "./secure-connect.zip",
+			Username:        "token",
+			PasswordRefresh: time.Minute,
+			Migrations:      "ignore",
+			Keyspace:        "movie_db",
+		},
+		Search: Search{
+			Driver: "embedded",
+		},
+		Auth: Auth{
+			JWKSPath:       "./jwks.json",
+			JWKSRefresh:    time.Minute,
+			Leeway:         30 * time.Second,
+			APIKeyCacheTTL: 30 * time.Second,
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			SampleRatio: 1,
+			ServiceName: "movie-server",
+		},
+		Metrics: Metrics{
+			Port: 9090,
+		},
+	}
+}
+
+// Load builds the configuration in layers, each overriding the one before:
+// Defaults, the YAML file named by --config, MOVIE_* environment variables
+// and finally command line flags. It returns the arguments left after the
+// flags. The result is not validated, see Validate.
+func Load(args []string, getenv func(string) string) (*Config, []string, error) {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	configPath := fs.String("config", "config.yaml", "path of the YAML configuration file")
+	port := fs.Int("port", 0, "port the gRPC server listens on")
+	timeout := fs.Duration("timeout", 0, "timeout for database connections and requests")
+	driver := fs.String("database-driver", "", `storage backend: "astra", "cassandra" or "memory"`)
+	migrations := fs.String("migrations", "", `startup schema check: "ignore", "check" or "auto"`)
+	searchDriver := fs.String("search-driver", "", `search engine: "embedded" or "sai"`)
+	metricsPort := fs.Int("metrics-port", 0, "port of the Prometheus /metrics listener, 0 turns it off")
+	tracingExporter := fs.String("tracing-exporter", "", `span exporter: "none", "stdout" or "otlp"`)
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	cfg := Defaults()
+
+	// A missing file is only an error when it was asked for explicitly
+	explicitConfig := false
+	fs.Visit(func(f *flag.Flag) {
+		explicitConfig = explicitConfig || f.Name == "config"
+	})
+	file, err := os.Open(*configPath)
+	switch {
+	case err == nil:
+		defer file.Close()
+		if err := cfg.LoadFile(file); err != nil {
+			return nil, nil, fmt.Errorf("config file %s: %w", *configPath, err)
+		}
+	case explicitConfig || !errors.Is(err, os.ErrNotExist):
+		return nil, nil, err
+	}
+
+	if err := cfg.loadEnv(getenv); err != nil {
+		return nil, nil, err
+	}
+
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "port":
+			cfg.Server.Port = *port
+		case "timeout":
+			cfg.Server.Timeout = *timeout
+		case "database-driver":
+			cfg.Database.Driver = *driver
+		case "migrations":
+			cfg.Database.Migrations = *migrations
+		case "search-driver":
+			cfg.Search.Driver = *searchDriver
+		case "metrics-port":
+			cfg.Metrics.Port = *metricsPort
+		case "tracing-exporter":
+			cfg.Tracing.Exporter = *tracingExporter
+		}
+	})
+	cfg.loadPassword(getenv)
+
+	return &cfg, fs.Args(), nil
+}
+
+// loadEnv overrides the settings whose MOVIE_* variable is set, except for
+// the database password, see loadPassword.
+func (c *Config) loadEnv(getenv func(string) string) error {
+	var errs []error
+	str := func(name string, dst *string) {
+		if v := getenv(name); v != "" {
+			*dst = v
+		}
+	}
+	num := func(name string, dst *int) {
+		if v := getenv(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*dst = n
+		}
+	}
+	boolean := func(name string, dst *bool) {
+		if v := getenv(name); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*dst = b
+		}
+	}
+
+	num("MOVIE_SERVER_PORT", &c.Server.Port)
+	if v := getenv("MOVIE_SERVER_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("MOVIE_SERVER_TIMEOUT: %w", err))
+		} else {
+			c.Server.Timeout = d
+		}
+	}
+
+	str("MOVIE_SERVER_TLS_CERT_PATH", &c.Server.TLS.CertPath)
+	str("MOVIE_SERVER_TLS_KEY_PATH", &c.Server.TLS.KeyPath)
+	str("MOVIE_SERVER_TLS_CLIENT_CA_PATH", &c.Server.TLS.ClientCAPath)
+
+	str("MOVIE_DATABASE_DRIVER", &c.Database.Driver)
+	str("MOVIE_DATABASE_PATH", &c.Database.Path)
+	str("MOVIE_DATABASE_USERNAME", &c.Database.Username)
+	str("MOVIE_DATABASE_PASSWORD_SOURCE", &c.Database.PasswordSource)
+	if v := getenv("MOVIE_DATABASE_PASSWORD_REFRESH"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("MOVIE_DATABASE_PASSWORD_REFRESH: %w", err))
+		} else {
+			c.Database.PasswordRefresh = d
+		}
+	}
+	str("MOVIE_DATABASE_MIGRATIONS", &c.Database.Migrations)
+	if v := getenv("MOVIE_DATABASE_HOSTS"); v != "" {
+		c.Database.Hosts = strings.Split(v, ",")
+	}
+	num("MOVIE_DATABASE_PORT", &c.Database.Port)
+	str("MOVIE_DATABASE_KEYSPACE", &c.Database.Keyspace)
+	str("MOVIE_DATABASE_CONSISTENCY", &c.Database.Consistency)
+	str("MOVIE_DATABASE_LOCAL_DC", &c.Database.LocalDC)
+	str("MOVIE_DATABASE_TLS_CERT_PATH", &c.Database.TLS.CertPath)
+	str("MOVIE_DATABASE_TLS_KEY_PATH", &c.Database.TLS.KeyPath)
+	str("MOVIE_DATABASE_TLS_CA_PATH", &c.Database.TLS.CAPath)
+	boolean("MOVIE_DATABASE_TLS_ENABLE_HOST_VERIFICATION", &c.Database.TLS.EnableHostVerification)
+	str("MOVIE_SEARCH_DRIVER", &c.Search.Driver)
+	boolean("MOVIE_AUTH_DISABLED", &c.Auth.Disabled)
+	str("MOVIE_AUTH_JWKS_PATH", &c.Auth.JWKSPath)
+	if v := getenv("MOVIE_AUTH_JWKS_REFRESH"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("MOVIE_AUTH_JWKS_REFRESH: %w", err))
+		} else {
+			c.Auth.JWKSRefresh = d
+		}
+	}
+	if v := getenv("MOVIE_AUTH_API_KEY_CACHE_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("MOVIE_AUTH_API_KEY_CACHE_TTL: %w", err))
+		} else {
+			c.Auth.APIKeyCacheTTL = d
+		}
+	}
+	str("MOVIE_AUTH_ISSUER", &c.Auth.Issuer)
+	str("MOVIE_AUTH_AUDIENCE", &c.Auth.Audience)
+	str("MOVIE_TRACING_EXPORTER", &c.Tracing.Exporter)
+	str("MOVIE_TRACING_ENDPOINT", &c.Tracing.Endpoint)
+	boolean("MOVIE_TRACING_INSECURE", &c.Tracing.Insecure)
+	if v := getenv("MOVIE_TRACING_SAMPLE_RATIO"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("MOVIE_TRACING_SAMPLE_RATIO: %w", err))
+		} else {
+			c.Tracing.SampleRatio = f
+		}
+	}
+	str("MOVIE_TRACING_SERVICE_NAME", &c.Tracing.ServiceName)
+	num("MOVIE_METRICS_PORT", &c.Metrics.Port)
+
+	return errors.Join(errs...)
+}
+
+// loadPassword reads the database password, which can only come from the
+// environment. ASTRA_DB_PASSWORD and CASSANDRA_PASSWORD are still read for
+// the matching driver, MOVIE_DATABASE_PASSWORD overrides both. It runs last
+// so the driver is the one the flags chose.
+func (c *Config) loadPassword(getenv func(string) string) {
+	var names []string
+	switch c.Database.Driver {
+	case "astra":
+		names = append(names, "ASTRA_DB_PASSWORD")
+	case "cassandra":
+		names = append(names, "CASSANDRA_PASSWORD")
+	}
+	for _, name := range append(names, "MOVIE_DATABASE_PASSWORD") {
+		if v := getenv(name); v != "" {
+			c.Database.Password = v
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// env returns a getenv reading vars.
+func env(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+// writeConfig writes a YAML file into a temporary directory and returns its
+// path.
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return path
+}
+
+func TestLoadLayers(t *testing.T) {
+	file := writeConfig(t, `
+server:
+  port: 6000
+  timeout: 5s
+database:
+  driver: cassandra
+  hosts: [file-host]
+search:
+  driver: sai
+`)
+
+	tests := []struct {
+		name    string
+		args    []string
+		env     map[string]string
+		port    int
+		timeout time.Duration
+		driver  string
+		search  string
+		hosts   []string
+	}{
+		{
+			// There is no config.yaml in the package directory
+			name:    "defaults",
+			port:    50051,
+			timeout: 20 * time.Second,
+			driver:  "astra",
+			search:  "embedded",
+		},
+		{
+			name:    "file over defaults",
+			args:    []string{"--config", file},
+			port:    6000,
+			timeout: 5 * time.Second,
+			driver:  "cassandra",
+			search:  "sai",
+			hosts:   []string{"file-host"},
+		},
+		{
+			name:    "env over file",
+			args:    []string{"--config", file},
+			env:     map[string]string{"MOVIE_SERVER_PORT": "7000", "MOVIE_DATABASE_HOSTS": "a,b"},
+			port:    7000,
+			timeout: 5 * time.Second,
+			driver:  "cassandra",
+			search:  "sai",
+			hosts:   []string{"a", "b"},
+		},
+		{
+			name:    "flags over env",
+			args:    []string{"--config", file, "--port", "8000", "--search-driver", "embedded"},
+			env:     map[string]string{"MOVIE_SERVER_PORT": "7000", "MOVIE_SEARCH_DRIVER": "sai"},
+			port:    8000,
+			timeout: 5 * time.Second,
+			driver:  "cassandra",
+			search:  "embedded",
+			hosts:   []string{"file-host"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _, err := Load(tt.args, env(tt.env))
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			if cfg.Server.Port != tt.port || cfg.Server.Timeout != tt.timeout {
+				t.Errorf("got port %d timeout %s, want %d %s", cfg.Server.Port, cfg.Server.Timeout, tt.port, tt.timeout)
+			}
+			if cfg.Database.Driver != tt.driver || cfg.Search.Driver != tt.search {
+				t.Errorf("got drivers %q %q, want %q %q", cfg.Database.Driver, cfg.Search.Driver, tt.driver, tt.search)
+			}
+			if len(cfg.Database.Hosts) != len(tt.hosts) {
+				t.Fatalf("got hosts %v, want %v", cfg.Database.Hosts, tt.hosts)
+			}
+			for i := range tt.hosts {
+				if cfg.Database.Hosts[i] != tt.hosts[i] {
+					t.Errorf("got hosts %v, want %v", cfg.Database.Hosts, tt.hosts)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadPassword(t *testing.T) {
+	secrets := map[string]string{
+		"ASTRA_DB_PASSWORD":  "astra-secret",
+		"CASSANDRA_PASSWORD": "cassandra-secret",
+	}
+	with := func(vars map[string]string) map[string]string {
+		all := map[string]string{}
+		for k, v := range secrets {
+			all[k] = v
+		}
+		for k, v := range vars {
+			all[k] = v
+		}
+		return all
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+		want string
+	}{
+		{"default driver", nil, secrets, "astra-secret"},
+		{"driver from env", nil, with(map[string]string{"MOVIE_DATABASE_DRIVER": "cassandra"}), "cassandra-secret"},
+		{"driver from flag", []string{"--database-driver", "cassandra"}, secrets, "cassandra-secret"},
+		{"flag over env driver", []string{"--database-driver", "astra"}, with(map[string]string{"MOVIE_DATABASE_DRIVER": "cassandra"}), "astra-secret"},
+		{"memory driver", []string{"--database-driver", "memory"}, secrets, ""},
+		{"override", []string{"--database-driver", "cassandra"}, with(map[string]string{"MOVIE_DATABASE_PASSWORD": "override"}), "override"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _, err := Load(tt.args, env(tt.env))
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			if cfg.Database.Password != tt.want {
+				t.Errorf("got password %q, want %q", cfg.Database.Password, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+	}{
+		{"missing explicit file", []string{"--config", filepath.Join(t.TempDir(), "none.yaml")}, nil},
+		{"malformed file", []string{"--config", writeConfig(t, "server: [")}, nil},
+		{"unknown flag", []string{"--nope"}, nil},
+		{"malformed int", nil, map[string]string{"MOVIE_SERVER_PORT": "http"}},
+		{"malformed duration", nil, map[string]string{"MOVIE_SERVER_TIMEOUT": "soon"}},
+		{"malformed bool", nil, map[string]string{"MOVIE_AUTH_DISABLED": "maybe"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Load(tt.args, env(tt.env)); err == nil {
+				t.Error("Load succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestLoadRemainingArgs(t *testing.T) {
+	_, rest, err := Load([]string{"--port", "6000", "migrate", "up"}, env(nil))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(rest) != 2 || rest[0] != "migrate" || rest[1] != "up" {
+		t.Errorf("got arguments %v, want [migrate up]", rest)
+	}
+}
@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/secrets"
+)
+
+// SessionSource hands out the session to run a query on. Callers should ask
+// for it once per operation and not keep it, it changes when credentials
+// are rotated.
+type SessionSource interface {
+	Session() *gocql.Session
+}
+
+// StaticSession is a SessionSource that always hands out session.
+func StaticSession(session *gocql.Session) SessionSource {
+	return staticSession{session}
+}
+
+type staticSession struct {
+	session *gocql.Session
+}
+
+func (s staticSession) Session() *gocql.Session {
+	return s.session
+}
+
+// RotatingSession is a SessionSource that opens a new session whenever the
+// database password changes.
+type RotatingSession struct {
+	current  atomic.Pointer[gocql.Session]
+	secret   secrets.Provider
+	connect  func(password string) (*gocql.Session, error)
+	grace    time.Duration
+	mu       sync.Mutex
+	password string
+}
+
+// NewRotatingSession reads the password from secret and connects with it.
+// After a rotation the replaced session is closed once grace has passed, so
+// queries already running on it can finish.
+func NewRotatingSession(ctx context.Context, secret secrets.Provider, grace time.Duration, connect func(password string) (*gocql.Session, error)) (*RotatingSession, error) {
+	password, err := secret.Secret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := connect(password)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &RotatingSession{secret: secret, connect: connect, grace: grace, password: password}
+	s.current.Store(session)
+	return s, nil
+}
+
+func (s *RotatingSession) Session() *gocql.Session {
+	return s.current.Load()
+}
+
+// Watch reads the secret every interval until ctx is done and reconnects
+// when it changed. A failed read or connection keeps the current session and
+// is retried at the next tick.
+func (s *RotatingSession) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Refresh(ctx); err != nil {
+				slog.Error("failed to rotate database credentials", "error", err)
+			}
+		}
+	}
+}
+
+// Refresh reconnects if the secret differs from the password of the current
+// session.
+func (s *RotatingSession) Refresh(ctx context.Context) error {
+	password, err := s.secret.Secret(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if password == s.password {
+		return nil
+	}
+
+	session, err := s.connect(password)
+	if err != nil {
+		return err
+	}
+	s.password = password
+	old := s.current.Swap(session)
+	time.AfterFunc(s.grace, old.Close)
+	slog.Info("Database credentials rotated, reconnected")
+	return nil
+}
+
+func (s *RotatingSession) Close() {
+	s.current.Load().Close()
+}
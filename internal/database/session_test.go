@@ -0,0 +1,224 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// rotatingSecret is a secrets.Provider whose value the test changes.
+type rotatingSecret struct {
+	mu    sync.Mutex
+	value string
+	err   error
+}
+
+func (p *rotatingSecret) Secret(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.value, p.err
+}
+
+func (p *rotatingSecret) set(value string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.value, p.err = value, err
+}
+
+// fakeDatabase hands out unconnected sessions, which can still be closed,
+// and remembers the password each was opened with.
+type fakeDatabase struct {
+	mu        sync.Mutex
+	passwords map[*gocql.Session]string
+	err       error
+}
+
+func (d *fakeDatabase) connect(password string) (*gocql.Session, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.err != nil {
+		return nil, d.err
+	}
+	session := &gocql.Session{}
+	d.passwords[session] = password
+	return session, nil
+}
+
+func (d *fakeDatabase) opened() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.passwords)
+}
+
+func (d *fakeDatabase) password(session *gocql.Session) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	password, ok := d.passwords[session]
+	return password, ok
+}
+
+func newRotating(t *testing.T, grace time.Duration) (*RotatingSession, *rotatingSecret, *fakeDatabase) {
+	t.Helper()
+	secret := &rotatingSecret{value: "first"}
+	db := &fakeDatabase{passwords: make(map[*gocql.Session]string)}
+	s, err := NewRotatingSession(context.Background(), secret, grace, db.connect)
+	if err != nil {
+		t.Fatalf("NewRotatingSession: %v", err)
+	}
+	return s, secret, db
+}
+
+// waitClosed waits up to a second for session to be closed.
+func waitClosed(t *testing.T, session *gocql.Session) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !session.Closed() {
+		if time.Now().After(deadline) {
+			t.Fatal("the replaced session was not closed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRotatingSessionRefresh(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unchanged", func(t *testing.T) {
+		s, _, db := newRotating(t, 0)
+		first := s.Session()
+		if err := s.Refresh(ctx); err != nil {
+			t.Fatalf("Refresh: %v", err)
+		}
+		if s.Session() != first || db.opened() != 1 {
+			t.Errorf("reconnected with an unchanged password, %d sessions opened", db.opened())
+		}
+	})
+
+	t.Run("rotated", func(t *testing.T) {
+		s, secret, db := newRotating(t, 0)
+		first := s.Session()
+		secret.set("second", nil)
+		if err := s.Refresh(ctx); err != nil {
+			t.Fatalf("Refresh: %v", err)
+		}
+		if password, _ := db.password(s.Session()); password != "second" {
+			t.Errorf("got a session opened with %q, want second", password)
+		}
+		waitClosed(t, first)
+		if s.Session().Closed() {
+			t.Error("the new session was closed")
+		}
+	})
+
+	t.Run("grace", func(t *testing.T) {
+		s, secret, _ := newRotating(t, time.Hour)
+		first := s.Session()
+		secret.set("second", nil)
+		if err := s.Refresh(ctx); err != nil {
+			t.Fatalf("Refresh: %v", err)
+		}
+		// Queries started on the old session have the grace period to finish
+		if first.Closed() {
+			t.Error("the replaced session was closed before the grace period passed")
+		}
+	})
+
+	t.Run("secret unreadable", func(t *testing.T) {
+		s, secret, _ := newRotating(t, 0)
+		first := s.Session()
+		secret.set("", errors.New("permission denied"))
+		if err := s.Refresh(ctx); err == nil {
+			t.Error("Refresh succeeded, want the secret's error")
+		}
+		if s.Session() != first || first.Closed() {
+			t.Error("a failed read replaced the session")
+		}
+	})
+
+	t.Run("connect fails", func(t *testing.T) {
+		s, secret, db := newRotating(t, 0)
+		first := s.Session()
+		secret.set("second", nil)
+		db.err = errors.New("authentication failed")
+		if err := s.Refresh(ctx); err == nil {
+			t.Fatal("Refresh succeeded, want the connection error")
+		}
+		if s.Session() != first || first.Closed() {
+			t.Error("a failed connection replaced the session")
+		}
+
+		// The next tick retries with the same password
+		db.err = nil
+		if err := s.Refresh(ctx); err != nil {
+			t.Fatalf("Refresh: %v", err)
+		}
+		if password, _ := db.password(s.Session()); password != "second" {
+			t.Errorf("got a session opened with %q, want second", password)
+		}
+	})
+}
+
+// TestRotatingSessionConcurrent rotates while callers keep asking for the
+// session, run it with -race.
+func TestRotatingSessionConcurrent(t *testing.T) {
+	ctx := context.Background()
+	s, secret, db := newRotating(t, 0)
+
+	stop := make(chan struct{})
+	var (
+		callers sync.WaitGroup
+		mu      sync.Mutex
+		unknown int
+	)
+	for i := 0; i < 8; i++ {
+		callers.Add(1)
+		go func() {
+			defer callers.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if _, ok := db.password(s.Session()); !ok {
+					mu.Lock()
+					unknown++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	// Several watchers seeing the same rotation reconnect only once
+	const rotations = 20
+	for i := 0; i < rotations; i++ {
+		secret.set(string(rune('a'+i)), nil)
+		var refreshes sync.WaitGroup
+		for j := 0; j < 4; j++ {
+			refreshes.Add(1)
+			go func() {
+				defer refreshes.Done()
+				if err := s.Refresh(ctx); err != nil {
+					t.Errorf("Refresh: %v", err)
+				}
+			}()
+		}
+		refreshes.Wait()
+	}
+	close(stop)
+	callers.Wait()
+
+	if unknown > 0 {
+		t.Errorf("callers got %d sessions that were never opened", unknown)
+	}
+	if got := db.opened(); got != rotations+1 {
+		t.Errorf("opened %d sessions, want %d", got, rotations+1)
+	}
+	if password, _ := db.password(s.Session()); password != string(rune('a'+rotations-1)) {
+		t.Errorf("got a session opened with %q, want the last password", password)
+	}
+}
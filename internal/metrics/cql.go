@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gocql/gocql"
+)
+
+// gocql does not expose the size of its connection pools, the pools are
+// described by the connections they open and the requests in flight on them.
+
+func (m *Metrics) ObserveQuery(ctx context.Context, q gocql.ObservedQuery) {
+	operation := "CQL"
+	if fields := strings.Fields(q.Statement); len(fields) > 0 {
+		operation = strings.ToUpper(fields[0])
+	}
+	m.queryDuration.WithLabelValues(operation, outcome(q.Err)).Observe(q.End.Sub(q.Start).Seconds())
+}
+
+func (m *Metrics) ObserveBatch(ctx context.Context, b gocql.ObservedBatch) {
+	m.batchStatements.Observe(float64(len(b.Statements)))
+	m.batchDuration.WithLabelValues(outcome(b.Err)).Observe(b.End.Sub(b.Start).Seconds())
+}
+
+func (m *Metrics) ObserveConnect(c gocql.ObservedConnect) {
+	m.connects.WithLabelValues(hostLabel(c.Host), outcome(c.Err)).Inc()
+	m.connectDuration.Observe(c.End.Sub(c.Start).Seconds())
+}
+
+// StreamContext tracks every request, gocql calls it before writing one to a
+// pool connection.
+func (m *Metrics) StreamContext(ctx context.Context) gocql.StreamObserverContext {
+	return streamObserver{m}
+}
+
+type streamObserver struct {
+	m *Metrics
+}
+
+func (s streamObserver) StreamStarted(o gocql.ObservedStream) {
+	s.m.requestsInFlight.WithLabelValues(hostLabel(o.Host)).Inc()
+}
+
+func (s streamObserver) StreamAbandoned(o gocql.ObservedStream) {
+	host := hostLabel(o.Host)
+	s.m.requestsInFlight.WithLabelValues(host).Dec()
+	s.m.requestsAbandoned.WithLabelValues(host).Inc()
+}
+
+func (s streamObserver) StreamFinished(o gocql.ObservedStream) {
+	s.m.requestsInFlight.WithLabelValues(hostLabel(o.Host)).Dec()
+}
+
+func hostLabel(host *gocql.HostInfo) string {
+	if host == nil {
+		return "unknown"
+	}
+	return host.ConnectAddressAndPort()
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor counts and times unary calls. Chain it first so that
+// calls rejected by later interceptors are counted too.
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor counts and times streaming calls and, for client
+// streams, the messages received on each.
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		if !info.IsClientStream {
+			err := handler(srv, ss)
+			m.observeRPC(info.FullMethod, start, err)
+			return err
+		}
+
+		counted := &countingStream{ServerStream: ss}
+		err := handler(srv, counted)
+		m.observeRPC(info.FullMethod, start, err)
+		service, method := splitMethod(info.FullMethod)
+		m.streamItems.WithLabelValues(service, method).Observe(float64(counted.received))
+		return err
+	}
+}
+
+func (m *Metrics) observeRPC(fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	m.rpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	m.rpcDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// splitMethod turns "/moviebase.UserService/GetUser" into
+// "moviebase.UserService" and "GetUser".
+func splitMethod(fullMethod string) (string, string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "unknown", fullMethod
+	}
+	return service, method
+}
+
+// countingStream counts the messages a handler receives. A handler only
+// reads its stream from one goroutine, so the count needs no lock.
+type countingStream struct {
+	grpc.ServerStream
+	received int
+}
+
+func (s *countingStream) RecvMsg(msg interface{}) error {
+	err := s.ServerStream.RecvMsg(msg)
+	if err == nil {
+		s.received++
+	}
+	return err
+}
@@ -0,0 +1,138 @@
+// Package metrics collects Prometheus metrics about the gRPC calls the server
+// handles and the requests it sends to Cassandra.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "movie"
+
+// Metrics holds the collectors. It provides the gRPC interceptors that feed
+// the RPC metrics and implements the gocql observers that feed the Cassandra
+// ones.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcHandled  *prometheus.CounterVec
+	rpcDuration *prometheus.HistogramVec
+	streamItems *prometheus.HistogramVec
+
+	queryDuration   *prometheus.HistogramVec
+	batchStatements prometheus.Histogram
+	batchDuration   *prometheus.HistogramVec
+
+	connects          *prometheus.CounterVec
+	connectDuration   prometheus.Histogram
+	requestsInFlight  *prometheus.GaugeVec
+	requestsAbandoned *prometheus.CounterVec
+}
+
+// New registers the collectors, along with the Go runtime and process ones,
+// on a registry of their own.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		rpcHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc_server",
+			Name:      "handled_total",
+			Help:      "RPCs completed by the server, by method and status code.",
+		}, []string{"service", "method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc_server",
+			Name:      "handling_seconds",
+			Help:      "Time from the start of an RPC until the server completed it.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "method"}),
+		streamItems: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc_server",
+			Name:      "stream_items",
+			Help:      "Messages received per client stream, such as the users of a CreateUsers call.",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+		}, []string{"service", "method"}),
+
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "cassandra",
+			Name:      "query_duration_seconds",
+			Help:      "Latency of a single query attempt, by CQL command.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "outcome"}),
+		batchStatements: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "cassandra",
+			Name:      "batch_statements",
+			Help:      "Statements per batch sent to Cassandra.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+		batchDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "cassandra",
+			Name:      "batch_duration_seconds",
+			Help:      "Latency of flushing a batch to Cassandra.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"outcome"}),
+
+		connects: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cassandra",
+			Name:      "connects_total",
+			Help:      "Connections the gocql pool opened, by host and outcome.",
+		}, []string{"host", "outcome"}),
+		connectDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "cassandra",
+			Name:      "connect_duration_seconds",
+			Help:      "Time taken to open a pool connection.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		requestsInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "cassandra",
+			Name:      "requests_in_flight",
+			Help:      "Requests written to a pool connection and waiting for a response, by host.",
+		}, []string{"host"}),
+		requestsAbandoned: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cassandra",
+			Name:      "requests_abandoned_total",
+			Help:      "Requests dropped because their connection closed, by host.",
+		}, []string{"host"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcHandled,
+		m.rpcDuration,
+		m.streamItems,
+		m.queryDuration,
+		m.batchStatements,
+		m.batchDuration,
+		m.connects,
+		m.connectDuration,
+		m.requestsInFlight,
+		m.requestsAbandoned,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func outcome(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
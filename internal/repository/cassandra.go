@@ -0,0 +1,617 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/database"
+)
+
+type cassandraUserRepository struct {
+	session database.SessionSource
+}
+
+func NewCassandraUserRepository(session database.SessionSource) UserRepository {
+	return &cassandraUserRepository{session: session}
+}
+
+func (r *cassandraUserRepository) CreateUsers(ctx context.Context, users []User) error {
+	stmt := `INSERT INTO users (id, name, alias_name) VALUES (?, ?, ?)`
+	session := r.session.Session()
+	batch := session.NewBatch(gocql.UnloggedBatch).WithContext(ctx) // Use unlogged batch for efficiency
+	for _, u := range users {
+		batch.Query(stmt, u.ID, u.Name, u.AliasName)
+	}
+	return session.ExecuteBatch(batch)
+}
+
+func (r *cassandraUserRepository) GetUser(ctx context.Context, id gocql.UUID) (*User, error) {
+	stmt := `SELECT name, alias_name FROM users WHERE id = ?`
+	user := User{ID: id}
+	if err := r.session.Session().Query(stmt, id).WithContext(ctx).Scan(&user.Name, &user.AliasName); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *cassandraUserRepository) UpdateUser(ctx context.Context, user User) error {
+	stmt := `UPDATE users SET name = ?, alias_name = ? WHERE id = ?`
+	return r.session.Session().Query(stmt, user.Name, user.AliasName, user.ID).WithContext(ctx).Exec()
+}
+
+func (r *cassandraUserRepository) DeleteUser(ctx context.Context, id gocql.UUID) error {
+	stmt := `DELETE FROM users WHERE id = ?`
+	return r.session.Session().Query(stmt, id).WithContext(ctx).Exec()
+}
+
+func (r *cassandraUserRepository) ListUsers(ctx context.Context, page Page) ([]User, []byte, error) {
+	stmt := `SELECT id, name, alias_name FROM users`
+	iter := paged(r.session.Session().Query(stmt).WithContext(ctx), page).Iter()
+
+	var (
+		users []User
+		u     User
+	)
+	for iter.Scan(&u.ID, &u.Name, &u.AliasName) {
+		users = append(users, u)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return users, iter.PageState(), nil
+}
+
+type cassandraCategoryRepository struct {
+	session database.SessionSource
+}
+
+func NewCassandraCategoryRepository(session database.SessionSource) CategoryRepository {
+	return &cassandraCategoryRepository{session: session}
+}
+
+func (r *cassandraCategoryRepository) CreateCategories(ctx context.Context, categories []Category) error {
+	stmt := `INSERT INTO categories (id, name, description) VALUES(?, ?, ?)`
+	session := r.session.Session()
+	batch := session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, c := range categories {
+		batch.Query(stmt, c.ID, c.Name, c.Description)
+	}
+	return session.ExecuteBatch(batch)
+}
+
+func (r *cassandraCategoryRepository) GetCategory(ctx context.Context, id gocql.UUID) (*Category, error) {
+	stmt := `SELECT name, description FROM categories WHERE id = ?`
+	category := Category{ID: id}
+	if err := r.session.Session().Query(stmt, id).WithContext(ctx).Scan(&category.Name, &category.Description); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *cassandraCategoryRepository) ListCategories(ctx context.Context, page Page) ([]Category, []byte, error) {
+	stmt := `SELECT id, name, description FROM categories`
+	iter := paged(r.session.Session().Query(stmt).WithContext(ctx), page).Iter()
+
+	var (
+		categories []Category
+		c          Category
+	)
+	for iter.Scan(&c.ID, &c.Name, &c.Description) {
+		categories = append(categories, c)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return categories, iter.PageState(), nil
+}
+
+func (r *cassandraCategoryRepository) ClaimCategoryName(ctx context.Context, name string, id gocql.UUID) (gocql.UUID, error) {
+	stmt := `INSERT INTO categories_by_name (name, id) VALUES (?, ?) IF NOT EXISTS`
+	existing := make(map[string]interface{})
+	applied, err := r.session.Session().Query(stmt, normalizeName(name), id).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return gocql.UUID{}, err
+	}
+	if applied {
+		return id, nil
+	}
+	holder, ok := existing["id"].(gocql.UUID)
+	if !ok {
+		return gocql.UUID{}, fmt.Errorf("repository: category name %q has no id", name)
+	}
+	return holder, nil
+}
+
+func (r *cassandraCategoryRepository) ReleaseCategoryName(ctx context.Context, name string, id gocql.UUID) error {
+	stmt := `DELETE FROM categories_by_name WHERE name = ? IF id = ?`
+	_, err := r.session.Session().Query(stmt, normalizeName(name), id).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+	return err
+}
+
+func (r *cassandraCategoryRepository) GetCategoryByName(ctx context.Context, name string) (*Category, error) {
+	stmt := `SELECT id FROM categories_by_name WHERE name = ?`
+	var id gocql.UUID
+	if err := r.session.Session().Query(stmt, normalizeName(name)).WithContext(ctx).Scan(&id); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return r.GetCategory(ctx, id)
+}
+
+// movies_by_id maps a movie_id to the rest of its movies_by_user primary key
+const (
+	insertMovieKeyStmt = `INSERT INTO movies_by_id (movie_id, user_id, category_id, created_at) VALUES(?, ?, ?, ?)`
+	deleteMovieKeyStmt = `DELETE FROM movies_by_id WHERE movie_id = ?`
+)
+
+// movies_by_category holds a copy of every movie partitioned by category_id
+// and CategoryBucket
+const (
+	insertMovieByCategoryStmt = `INSERT INTO movies_by_category (category_id, bucket, created_at, movie_id, user_id, name, banner_url, movie_url, description, updated_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	deleteMovieByCategoryStmt = `DELETE FROM movies_by_category WHERE category_id = ? AND bucket = ? AND created_at = ? AND movie_id = ?`
+)
+
+type cassandraMovieRepository struct {
+	session database.SessionSource
+}
+
+func NewCassandraMovieRepository(session database.SessionSource) MovieRepository {
+	return &cassandraMovieRepository{session: session}
+}
+
+// createMoviesConcurrency bounds how many movies CreateMovies writes at once.
+const createMoviesConcurrency = 8
+
+// CreateMovies writes each movie to its three tables in a logged batch of its
+// own, so no movie is left in one table without the others. A batch spanning
+// many movies would span as many partitions and soon exceed the server's
+// batch size limit. When it fails some of the movies may have been written.
+func (r *cassandraMovieRepository) CreateMovies(ctx context.Context, movies []Movie) error {
+	session := r.session.Session()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	slots := make(chan struct{}, createMoviesConcurrency)
+	for _, m := range movies {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			if err := session.ExecuteBatch(movieBatch(session, m).WithContext(ctx)); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("movie %s: %w", m.MovieID, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// movieBatch writes m to movies_by_user, movies_by_id and movies_by_category.
+func movieBatch(session *gocql.Session, m Movie) *gocql.Batch {
+	stmt := `INSERT INTO movies_by_user (user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	batch := session.NewBatch(gocql.LoggedBatch)
+	batch.Query(stmt, m.UserID, m.MovieID, m.CategoryID, m.Name, m.BannerURL, m.MovieURL, m.Description, m.CreatedAt, m.UpdatedAt)
+	batch.Query(insertMovieKeyStmt, m.MovieID, m.UserID, m.CategoryID, m.CreatedAt)
+	batch.Query(insertMovieByCategoryStmt, movieByCategoryArgs(m)...)
+	return batch
+}
+
+func (r *cassandraMovieRepository) ListMoviesByUserAndCategory(ctx context.Context, userID, categoryID gocql.UUID) ([]Movie, error) {
+	stmt := `SELECT user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at FROM movies_by_user WHERE user_id = ? AND category_id = ?`
+	movies, _, err := scanMovies(r.session.Session().Query(stmt, userID, categoryID).WithContext(ctx))
+	return movies, err
+}
+
+func (r *cassandraMovieRepository) ListMoviesByUser(ctx context.Context, userID gocql.UUID, page Page) ([]Movie, []byte, error) {
+	stmt := `SELECT user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at
+			FROM movies_by_user
+			WHERE user_id = ?`
+	return scanMovies(paged(r.session.Session().Query(stmt, userID).WithContext(ctx), page))
+}
+
+func (r *cassandraMovieRepository) ListMoviesByUserAndName(ctx context.Context, userID gocql.UUID, name string, page Page) ([]Movie, []byte, error) {
+	// name is served by the list_movies_by_names SAI index
+	stmt := `SELECT user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at FROM movies_by_user WHERE user_id = ? AND name = ?`
+	return scanMovies(paged(r.session.Session().Query(stmt, userID, name).WithContext(ctx), page))
+}
+
+func (r *cassandraMovieRepository) ListMoviesByUserAndCategoryCreatedBetween(ctx context.Context, userID, categoryID gocql.UUID, start, end *time.Time, page Page) ([]Movie, []byte, error) {
+	stmt := `SELECT user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at FROM movies_by_user WHERE user_id = ? AND category_id = ?`
+	args := []interface{}{userID, categoryID}
+	if start != nil {
+		stmt += ` AND created_at >= ?`
+		args = append(args, *start)
+	}
+	if end != nil {
+		stmt += ` AND created_at <= ?`
+		args = append(args, *end)
+	}
+	return scanMovies(paged(r.session.Session().Query(stmt, args...).WithContext(ctx), page))
+}
+
+func (r *cassandraMovieRepository) ListMoviesByCategory(ctx context.Context, categoryID gocql.UUID, page Page) ([]Movie, []byte, error) {
+	stmt := `SELECT user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at FROM movies_by_category WHERE category_id = ? AND bucket = ?`
+
+	// The buckets are read one after the other, the paging state records the
+	// current bucket in front of the driver's paging state for it
+	bucket, state, err := decodeBucketPagingState(page.State)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var movies []Movie
+	for bucket < CategoryBuckets {
+		size := 0
+		if page.Size > 0 {
+			size = page.Size - len(movies)
+		}
+		rows, next, err := scanMovies(paged(r.session.Session().Query(stmt, categoryID, bucket).WithContext(ctx), Page{Size: size, State: state}))
+		if err != nil {
+			return nil, nil, err
+		}
+		movies = append(movies, rows...)
+
+		if len(next) > 0 {
+			state = next
+		} else {
+			bucket, state = bucket+1, nil
+		}
+		if page.Size > 0 && len(movies) >= page.Size {
+			break
+		}
+	}
+
+	if bucket == CategoryBuckets {
+		return movies, nil, nil
+	}
+	return movies, encodeBucketPagingState(bucket, state), nil
+}
+
+func (r *cassandraMovieRepository) GetMovie(ctx context.Context, movieID gocql.UUID) (*Movie, error) {
+	// movie_id sits behind category_id and created_at in the clustering key,
+	// so resolve the rest of the primary key from the lookup table first
+	keyStmt := `SELECT user_id, category_id, created_at FROM movies_by_id WHERE movie_id = ?`
+	key := Movie{MovieID: movieID}
+	if err := r.session.Session().Query(keyStmt, movieID).WithContext(ctx).Scan(&key.UserID, &key.CategoryID, &key.CreatedAt); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	stmt := `SELECT user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at FROM movies_by_user WHERE user_id = ? AND category_id = ? AND created_at = ? AND movie_id = ?`
+	movies, _, err := scanMovies(r.session.Session().Query(stmt, key.UserID, key.CategoryID, key.CreatedAt, key.MovieID).WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(movies) == 0 {
+		return nil, ErrNotFound
+	}
+	return &movies[0], nil
+}
+
+func (r *cassandraMovieRepository) GetUserMovie(ctx context.Context, userID, movieID gocql.UUID) (*Movie, error) {
+	movie, err := r.GetMovie(ctx, movieID)
+	if err == nil {
+		if movie.UserID != userID {
+			return nil, ErrNotFound
+		}
+		return movie, nil
+	}
+	if err != ErrNotFound {
+		return nil, err
+	}
+
+	// Until backfill_movies_by_id ran, older movies have no lookup entry.
+	// Filtering stays within the user's partition.
+	stmt := `SELECT user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at FROM movies_by_user WHERE user_id = ? AND movie_id = ? ALLOW FILTERING`
+	movies, _, err := scanMovies(r.session.Session().Query(stmt, userID, movieID).WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(movies) == 0 {
+		return nil, ErrNotFound
+	}
+	return &movies[0], nil
+}
+
+func (r *cassandraMovieRepository) UpdateMovie(ctx context.Context, previous, movie Movie) error {
+	insertStmt := `INSERT INTO movies_by_user (user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	deleteStmt := `DELETE FROM movies_by_user WHERE user_id = ? AND category_id = ? AND created_at = ? AND movie_id = ?`
+
+	// A logged batch makes sure the old row, the new row and the lookup
+	// entry end up consistent even if the coordinator fails midway. It has to
+	// run on the session that created it, so fetch that only once.
+	session := r.session.Session()
+	batch := session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	if previous.CategoryID != movie.CategoryID || !previous.CreatedAt.Equal(movie.CreatedAt) {
+		batch.Query(deleteStmt, previous.UserID, previous.CategoryID, previous.CreatedAt, previous.MovieID)
+		batch.Query(deleteMovieByCategoryStmt, previous.CategoryID, CategoryBucket(previous.MovieID), previous.CreatedAt, previous.MovieID)
+		batch.Query(insertMovieKeyStmt, movie.MovieID, movie.UserID, movie.CategoryID, movie.CreatedAt)
+	}
+	batch.Query(insertStmt, movie.UserID, movie.MovieID, movie.CategoryID, movie.Name, movie.BannerURL, movie.MovieURL, movie.Description, movie.CreatedAt, movie.UpdatedAt)
+	batch.Query(insertMovieByCategoryStmt, movieByCategoryArgs(movie)...)
+	return session.ExecuteBatch(batch)
+}
+
+func (r *cassandraMovieRepository) DeleteMovie(ctx context.Context, movie Movie) error {
+	stmt := `DELETE FROM movies_by_user WHERE user_id = ? AND category_id = ? AND created_at = ? AND movie_id = ?`
+	session := r.session.Session()
+	batch := session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(stmt, movie.UserID, movie.CategoryID, movie.CreatedAt, movie.MovieID)
+	batch.Query(deleteMovieKeyStmt, movie.MovieID)
+	batch.Query(deleteMovieByCategoryStmt, movie.CategoryID, CategoryBucket(movie.MovieID), movie.CreatedAt, movie.MovieID)
+	return session.ExecuteBatch(batch)
+}
+
+func (r *cassandraMovieRepository) DeleteMoviesByCategory(ctx context.Context, userID, categoryID gocql.UUID) (int, error) {
+	// A range delete does not report which rows it covered, so read their
+	// keys first, they are also needed to clean up the other movie tables
+	selectStmt := `SELECT movie_id, category_id, created_at FROM movies_by_user WHERE user_id = ? AND category_id = ?`
+	movies, err := scanMovieKeys(r.session.Session().Query(selectStmt, userID, categoryID).WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	if len(movies) == 0 {
+		return 0, nil
+	}
+
+	deleteStmt := `DELETE FROM movies_by_user WHERE user_id = ? AND category_id = ?`
+	if err := r.session.Session().Query(deleteStmt, userID, categoryID).WithContext(ctx).Exec(); err != nil {
+		return 0, err
+	}
+
+	if err := r.deleteMovieCopies(ctx, movies); err != nil {
+		return 0, err
+	}
+	return len(movies), nil
+}
+
+func (r *cassandraMovieRepository) DeleteMoviesByUser(ctx context.Context, userID gocql.UUID) (int, error) {
+	selectStmt := `SELECT movie_id, category_id, created_at FROM movies_by_user WHERE user_id = ?`
+	movies, err := scanMovieKeys(r.session.Session().Query(selectStmt, userID).WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	// Dropping the whole partition also clears the orphaned_at static column
+	deleteStmt := `DELETE FROM movies_by_user WHERE user_id = ?`
+	if err := r.session.Session().Query(deleteStmt, userID).WithContext(ctx).Exec(); err != nil {
+		return 0, err
+	}
+
+	if err := r.deleteMovieCopies(ctx, movies); err != nil {
+		return 0, err
+	}
+	return len(movies), nil
+}
+
+func (r *cassandraMovieRepository) OrphanMoviesByUser(ctx context.Context, userID gocql.UUID, at time.Time) error {
+	// Setting the static column of an empty partition would create one holding
+	// nothing but orphaned_at, so a user without movies is left alone
+	var movieID gocql.UUID
+	selectStmt := `SELECT movie_id FROM movies_by_user WHERE user_id = ? LIMIT 1`
+	err := r.session.Session().Query(selectStmt, userID).WithContext(ctx).Scan(&movieID)
+	if err == gocql.ErrNotFound || (err == nil && movieID == (gocql.UUID{})) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	stmt := `UPDATE movies_by_user SET orphaned_at = ? WHERE user_id = ?`
+	return r.session.Session().Query(stmt, at, userID).WithContext(ctx).Exec()
+}
+
+// deleteMovieCopies removes the movies_by_id and movies_by_category rows of
+// movies in batches of 100 statements.
+func (r *cassandraMovieRepository) deleteMovieCopies(ctx context.Context, movies []Movie) error {
+	session := r.session.Session()
+	batch := session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, m := range movies {
+		batch.Query(deleteMovieKeyStmt, m.MovieID)
+		batch.Query(deleteMovieByCategoryStmt, m.CategoryID, CategoryBucket(m.MovieID), m.CreatedAt, m.MovieID)
+		if batch.Size() >= 100 {
+			if err := session.ExecuteBatch(batch); err != nil {
+				return err
+			}
+			batch = session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+		}
+	}
+	if batch.Size() > 0 {
+		return session.ExecuteBatch(batch)
+	}
+	return nil
+}
+
+// paged applies the page size and paging state to a query. Setting the paging
+// state, even an empty one, also stops gocql from fetching the following pages.
+func paged(query *gocql.Query, page Page) *gocql.Query {
+	return query.PageSize(page.Size).PageState(page.State)
+}
+
+// rowIter is the part of *gocql.Iter the scanners use.
+type rowIter interface {
+	Scan(dest ...interface{}) bool
+	Close() error
+	PageState() []byte
+}
+
+// scanMovieKeys reads the movie_id, category_id and created_at of every row
+// selected, enough to find a movie's copies in the other movie tables.
+func scanMovieKeys(query *gocql.Query) ([]Movie, error) {
+	return scanMovieKeyRows(query.Iter())
+}
+
+func scanMovieKeyRows(iter rowIter) ([]Movie, error) {
+	var (
+		movies []Movie
+		m      Movie
+	)
+	for iter.Scan(&m.MovieID, &m.CategoryID, &m.CreatedAt) {
+		// A partition holding only the orphaned_at static column has no movie
+		if m.MovieID == (gocql.UUID{}) {
+			continue
+		}
+		movies = append(movies, m)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return movies, nil
+}
+
+func movieByCategoryArgs(m Movie) []interface{} {
+	return []interface{}{m.CategoryID, CategoryBucket(m.MovieID), m.CreatedAt, m.MovieID, m.UserID, m.Name, m.BannerURL, m.MovieURL, m.Description, m.UpdatedAt}
+}
+
+// A movies_by_category paging state is the bucket to continue in followed by
+// the driver's paging state within that bucket.
+func encodeBucketPagingState(bucket int, state []byte) []byte {
+	return append([]byte{byte(bucket)}, state...)
+}
+
+func decodeBucketPagingState(state []byte) (int, []byte, error) {
+	if len(state) == 0 {
+		return 0, nil, nil
+	}
+	if int(state[0]) >= CategoryBuckets {
+		return 0, nil, ErrInvalidPagingState
+	}
+	return int(state[0]), state[1:], nil
+}
+
+// scanMovies reads movies_by_user rows selected in table column order and
+// returns them with the paging state of the next page.
+func scanMovies(query *gocql.Query) ([]Movie, []byte, error) {
+	return scanMovieRows(query.Iter())
+}
+
+func scanMovieRows(iter rowIter) ([]Movie, []byte, error) {
+	var (
+		movies []Movie
+		m      Movie
+	)
+	for iter.Scan(&m.UserID, &m.MovieID, &m.CategoryID, &m.Name, &m.BannerURL, &m.MovieURL, &m.Description, &m.CreatedAt, &m.UpdatedAt) {
+		if m.MovieID == (gocql.UUID{}) {
+			continue
+		}
+		movies = append(movies, m)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return movies, iter.PageState(), nil
+}
+
+type cassandraIdempotencyRepository struct {
+	session database.SessionSource
+}
+
+func NewCassandraIdempotencyRepository(session database.SessionSource) IdempotencyRepository {
+	return &cassandraIdempotencyRepository{session: session}
+}
+
+func (r *cassandraIdempotencyRepository) ClaimKey(ctx context.Context, scope, key string, id gocql.UUID) (gocql.UUID, bool, error) {
+	// The lightweight transaction makes concurrent retries agree on one id
+	stmt := `INSERT INTO idempotency_keys (scope, request_key, id) VALUES (?, ?, ?) IF NOT EXISTS`
+	existing := make(map[string]interface{})
+	applied, err := r.session.Session().Query(stmt, scope, key, id).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return gocql.UUID{}, false, err
+	}
+	if applied {
+		return id, true, nil
+	}
+	original, ok := existing["id"].(gocql.UUID)
+	if !ok {
+		return gocql.UUID{}, false, fmt.Errorf("repository: idempotency key %q has no id", key)
+	}
+	return original, false, nil
+}
+
+type cassandraAPIKeyRepository struct {
+	session database.SessionSource
+}
+
+func NewCassandraAPIKeyRepository(session database.SessionSource) APIKeyRepository {
+	return &cassandraAPIKeyRepository{session: session}
+}
+
+func (r *cassandraAPIKeyRepository) CreateAPIKey(ctx context.Context, key APIKey) error {
+	stmt := `INSERT INTO api_keys (id, name, secret_hash, scopes, created_at) VALUES (?, ?, ?, ?, ?)`
+	return r.session.Session().Query(stmt, key.ID, key.Name, key.SecretHash, key.Scopes, key.CreatedAt).WithContext(ctx).Exec()
+}
+
+func (r *cassandraAPIKeyRepository) GetAPIKey(ctx context.Context, id gocql.UUID) (*APIKey, error) {
+	stmt := `SELECT name, secret_hash, scopes, created_at, revoked_at FROM api_keys WHERE id = ?`
+	key := APIKey{ID: id}
+	if err := r.session.Session().Query(stmt, id).WithContext(ctx).Scan(&key.Name, &key.SecretHash, &key.Scopes, &key.CreatedAt, &key.RevokedAt); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *cassandraAPIKeyRepository) ListAPIKeys(ctx context.Context, page Page) ([]APIKey, []byte, error) {
+	stmt := `SELECT id, name, scopes, created_at, revoked_at FROM api_keys`
+	iter := paged(r.session.Session().Query(stmt).WithContext(ctx), page).Iter()
+
+	var (
+		keys []APIKey
+		k    APIKey
+	)
+	for iter.Scan(&k.ID, &k.Name, &k.Scopes, &k.CreatedAt, &k.RevokedAt) {
+		keys = append(keys, k)
+		// Scan reuses the slice otherwise
+		k.Scopes = nil
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return keys, iter.PageState(), nil
+}
+
+func (r *cassandraAPIKeyRepository) RevokeAPIKey(ctx context.Context, id gocql.UUID, at time.Time) error {
+	// IF EXISTS keeps an unknown id from creating a row with only revoked_at
+	stmt := `UPDATE api_keys SET revoked_at = ? WHERE id = ? IF EXISTS`
+	applied, err := r.session.Session().Query(stmt, at, id).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+func TestMovieBatch(t *testing.T) {
+	// Building a batch does not need a connected session
+	session := &gocql.Session{}
+	now := time.Now()
+	m := Movie{
+		UserID:      gocql.TimeUUID(),
+		MovieID:     gocql.TimeUUID(),
+		CategoryID:  gocql.TimeUUID(),
+		Name:        "Metropolis",
+		Description: "A city of the future",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	batch := movieBatch(session, m)
+	if batch.Type != gocql.LoggedBatch {
+		t.Errorf("got batch type %v, want a logged batch", batch.Type)
+	}
+	// One row per table, all of them for this movie alone
+	if len(batch.Entries) != 3 {
+		t.Fatalf("got %d statements, want 3", len(batch.Entries))
+	}
+	for _, e := range batch.Entries {
+		found := false
+		for _, arg := range e.Args {
+			found = found || arg == m.MovieID
+		}
+		if !found {
+			t.Errorf("statement %q does not write movie %s", e.Stmt, m.MovieID)
+		}
+	}
+}
+
+// fakeIter yields rows as *gocql.Iter would, one value per selected column.
+type fakeIter struct {
+	rows [][]interface{}
+}
+
+func (it *fakeIter) Scan(dest ...interface{}) bool {
+	if len(it.rows) == 0 {
+		return false
+	}
+	row := it.rows[0]
+	it.rows = it.rows[1:]
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(row[i]))
+	}
+	return true
+}
+
+func (it *fakeIter) Close() error      { return nil }
+func (it *fakeIter) PageState() []byte { return nil }
+
+func TestScanSkipsStaticOnlyRows(t *testing.T) {
+	now := time.Now()
+	userID := gocql.TimeUUID()
+	movie := newMovie(userID, gocql.TimeUUID(), now, "Metropolis")
+	// Cassandra returns the static column of an otherwise empty partition as
+	// a row whose clustering and regular columns are all null
+	static := Movie{UserID: userID}
+	row := func(m Movie) []interface{} {
+		return []interface{}{m.UserID, m.MovieID, m.CategoryID, m.Name, m.BannerURL, m.MovieURL, m.Description, m.CreatedAt, m.UpdatedAt}
+	}
+	key := func(m Movie) []interface{} {
+		return []interface{}{m.MovieID, m.CategoryID, m.CreatedAt}
+	}
+
+	t.Run("movies", func(t *testing.T) {
+		movies, _, err := scanMovieRows(&fakeIter{rows: [][]interface{}{row(movie), row(static)}})
+		if err != nil {
+			t.Fatalf("scanMovieRows: %v", err)
+		}
+		if len(movies) != 1 || movies[0].MovieID != movie.MovieID {
+			t.Errorf("got %v, want only %s", movies, movie.MovieID)
+		}
+		movies, _, err = scanMovieRows(&fakeIter{rows: [][]interface{}{row(static)}})
+		if err != nil || len(movies) != 0 {
+			t.Errorf("got %v, %v for a static-only partition, want no movies", movies, err)
+		}
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		movies, err := scanMovieKeyRows(&fakeIter{rows: [][]interface{}{key(static), key(movie)}})
+		if err != nil {
+			t.Fatalf("scanMovieKeyRows: %v", err)
+		}
+		if len(movies) != 1 || movies[0].MovieID != movie.MovieID {
+			t.Errorf("got %v, want only %s", movies, movie.MovieID)
+		}
+	})
+}
@@ -0,0 +1,583 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// MemoryStore holds the users, categories and movies_by_user tables in process
+// memory. It is shared by the memory repositories the same way a database
+// session is shared by the Cassandra ones.
+type MemoryStore struct {
+	mu         sync.RWMutex
+	users      map[gocql.UUID]User
+	categories map[gocql.UUID]Category
+	// movies is partitioned by user_id, each partition is kept sorted in
+	// clustering order: category_id ASC, created_at DESC, movie_id ASC.
+	movies map[gocql.UUID][]Movie
+	// moviesByID indexes the rows of movies by movie_id, like movies_by_id
+	moviesByID map[gocql.UUID]Movie
+	// moviesByCategory holds the rows of movies again partitioned by
+	// category_id, the buckets of movies_by_category are kept in one
+	// partition ordered by bucket first
+	moviesByCategory map[gocql.UUID][]Movie
+	// orphanedAt holds the orphaned_at static column of each partition
+	orphanedAt map[gocql.UUID]time.Time
+	// categoryNames maps a normalized category name to the category holding
+	// it, like categories_by_name
+	categoryNames map[string]gocql.UUID
+	// idempotencyKeys maps a scope and request key to the id it was given
+	idempotencyKeys map[idempotencyKey]gocql.UUID
+	// apiKeys is the api_keys table
+	apiKeys map[gocql.UUID]APIKey
+}
+
+type idempotencyKey struct {
+	scope string
+	key   string
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		users:            make(map[gocql.UUID]User),
+		categories:       make(map[gocql.UUID]Category),
+		movies:           make(map[gocql.UUID][]Movie),
+		moviesByID:       make(map[gocql.UUID]Movie),
+		moviesByCategory: make(map[gocql.UUID][]Movie),
+		orphanedAt:       make(map[gocql.UUID]time.Time),
+		categoryNames:    make(map[string]gocql.UUID),
+		idempotencyKeys:  make(map[idempotencyKey]gocql.UUID),
+		apiKeys:          make(map[gocql.UUID]APIKey),
+	}
+}
+
+type memoryUserRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryUserRepository(store *MemoryStore) UserRepository {
+	return &memoryUserRepository{store: store}
+}
+
+func (r *memoryUserRepository) CreateUsers(ctx context.Context, users []User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	for _, u := range users {
+		r.store.users[u.ID] = u
+	}
+	return nil
+}
+
+func (r *memoryUserRepository) GetUser(ctx context.Context, id gocql.UUID) (*User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	user, ok := r.store.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &user, nil
+}
+
+func (r *memoryUserRepository) UpdateUser(ctx context.Context, user User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.users[user.ID] = user
+	return nil
+}
+
+func (r *memoryUserRepository) DeleteUser(ctx context.Context, id gocql.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	delete(r.store.users, id)
+	return nil
+}
+
+func (r *memoryUserRepository) ListUsers(ctx context.Context, page Page) ([]User, []byte, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	return listByID(r.store.users, page)
+}
+
+type memoryCategoryRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryCategoryRepository(store *MemoryStore) CategoryRepository {
+	return &memoryCategoryRepository{store: store}
+}
+
+func (r *memoryCategoryRepository) CreateCategories(ctx context.Context, categories []Category) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	for _, c := range categories {
+		r.store.categories[c.ID] = c
+	}
+	return nil
+}
+
+func (r *memoryCategoryRepository) GetCategory(ctx context.Context, id gocql.UUID) (*Category, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	category, ok := r.store.categories[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &category, nil
+}
+
+func (r *memoryCategoryRepository) ListCategories(ctx context.Context, page Page) ([]Category, []byte, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	return listByID(r.store.categories, page)
+}
+
+func (r *memoryCategoryRepository) ClaimCategoryName(ctx context.Context, name string, id gocql.UUID) (gocql.UUID, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	key := normalizeName(name)
+	if holder, ok := r.store.categoryNames[key]; ok {
+		return holder, nil
+	}
+	r.store.categoryNames[key] = id
+	return id, nil
+}
+
+func (r *memoryCategoryRepository) ReleaseCategoryName(ctx context.Context, name string, id gocql.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	key := normalizeName(name)
+	if r.store.categoryNames[key] == id {
+		delete(r.store.categoryNames, key)
+	}
+	return nil
+}
+
+func (r *memoryCategoryRepository) GetCategoryByName(ctx context.Context, name string) (*Category, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	id, ok := r.store.categoryNames[normalizeName(name)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	category, ok := r.store.categories[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &category, nil
+}
+
+type memoryMovieRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryMovieRepository(store *MemoryStore) MovieRepository {
+	return &memoryMovieRepository{store: store}
+}
+
+func (r *memoryMovieRepository) CreateMovies(ctx context.Context, movies []Movie) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	for _, m := range movies {
+		// Cassandra timestamps only keep millisecond precision
+		m.CreatedAt = m.CreatedAt.Truncate(time.Millisecond)
+		m.UpdatedAt = m.UpdatedAt.Truncate(time.Millisecond)
+		r.store.upsertMovie(m)
+	}
+	return nil
+}
+
+func (r *memoryMovieRepository) ListMoviesByUserAndCategory(ctx context.Context, userID, categoryID gocql.UUID) ([]Movie, error) {
+	movies, _, err := r.store.listMovies(userID, Page{}, func(m *Movie) bool {
+		return m.CategoryID == categoryID
+	})
+	return movies, err
+}
+
+func (r *memoryMovieRepository) ListMoviesByUser(ctx context.Context, userID gocql.UUID, page Page) ([]Movie, []byte, error) {
+	return r.store.listMovies(userID, page, func(m *Movie) bool {
+		return true
+	})
+}
+
+func (r *memoryMovieRepository) ListMoviesByUserAndName(ctx context.Context, userID gocql.UUID, name string, page Page) ([]Movie, []byte, error) {
+	return r.store.listMovies(userID, page, func(m *Movie) bool {
+		return m.Name == name
+	})
+}
+
+func (r *memoryMovieRepository) ListMoviesByUserAndCategoryCreatedBetween(ctx context.Context, userID, categoryID gocql.UUID, start, end *time.Time, page Page) ([]Movie, []byte, error) {
+	return r.store.listMovies(userID, page, func(m *Movie) bool {
+		if m.CategoryID != categoryID {
+			return false
+		}
+		if start != nil && m.CreatedAt.Before(*start) {
+			return false
+		}
+		if end != nil && m.CreatedAt.After(*end) {
+			return false
+		}
+		return true
+	})
+}
+
+func (r *memoryMovieRepository) ListMoviesByCategory(ctx context.Context, categoryID gocql.UUID, page Page) ([]Movie, []byte, error) {
+	return r.store.listPartition(r.store.moviesByCategory, categoryID, compareCategoryClustering, page, func(m *Movie) bool {
+		return true
+	})
+}
+
+func (r *memoryMovieRepository) GetMovie(ctx context.Context, movieID gocql.UUID) (*Movie, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	movie, ok := r.store.moviesByID[movieID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &movie, nil
+}
+
+func (r *memoryMovieRepository) GetUserMovie(ctx context.Context, userID, movieID gocql.UUID) (*Movie, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	movie, ok := r.store.moviesByID[movieID]
+	if !ok || movie.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return &movie, nil
+}
+
+func (r *memoryMovieRepository) UpdateMovie(ctx context.Context, previous, movie Movie) error {
+	movie.CreatedAt = movie.CreatedAt.Truncate(time.Millisecond)
+	movie.UpdatedAt = movie.UpdatedAt.Truncate(time.Millisecond)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.deleteMovie(&previous)
+	r.store.upsertMovie(movie)
+	return nil
+}
+
+func (r *memoryMovieRepository) DeleteMovie(ctx context.Context, movie Movie) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.deleteMovie(&movie)
+	return nil
+}
+
+func (r *memoryMovieRepository) DeleteMoviesByCategory(ctx context.Context, userID, categoryID gocql.UUID) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	kept := r.store.movies[userID][:0]
+	for _, m := range r.store.movies[userID] {
+		if m.CategoryID != categoryID {
+			kept = append(kept, m)
+			continue
+		}
+		delete(r.store.moviesByID, m.MovieID)
+		removeSorted(r.store.moviesByCategory, m.CategoryID, &m, compareCategoryClustering)
+	}
+	deleted := len(r.store.movies[userID]) - len(kept)
+	r.store.movies[userID] = kept
+	return deleted, nil
+}
+
+func (r *memoryMovieRepository) DeleteMoviesByUser(ctx context.Context, userID gocql.UUID) (int, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	deleted := len(r.store.movies[userID])
+	for _, m := range r.store.movies[userID] {
+		delete(r.store.moviesByID, m.MovieID)
+		removeSorted(r.store.moviesByCategory, m.CategoryID, &m, compareCategoryClustering)
+	}
+	delete(r.store.movies, userID)
+	delete(r.store.orphanedAt, userID)
+	return deleted, nil
+}
+
+func (r *memoryMovieRepository) OrphanMoviesByUser(ctx context.Context, userID gocql.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if len(r.store.movies[userID]) == 0 {
+		return nil
+	}
+	r.store.orphanedAt[userID] = at.Truncate(time.Millisecond)
+	return nil
+}
+
+// upsertMovie writes m to movies_by_user and keeps movies_by_id and
+// movies_by_category in sync, replacing a row with the same primary key. The
+// caller must hold the write lock.
+func (s *MemoryStore) upsertMovie(m Movie) {
+	s.moviesByID[m.MovieID] = m
+	insertSorted(s.movies, m.UserID, m, compareClustering)
+	insertSorted(s.moviesByCategory, m.CategoryID, m, compareCategoryClustering)
+}
+
+// deleteMovie removes the row with the same primary key as m, if any, from
+// every movie table. The caller must hold the write lock.
+func (s *MemoryStore) deleteMovie(m *Movie) bool {
+	if !removeSorted(s.movies, m.UserID, m, compareClustering) {
+		return false
+	}
+	removeSorted(s.moviesByCategory, m.CategoryID, m, compareCategoryClustering)
+	delete(s.moviesByID, m.MovieID)
+	return true
+}
+
+// insertSorted inserts m into the partition of table at key in the order
+// given by compare, replacing a row that compares equal.
+func insertSorted(table map[gocql.UUID][]Movie, key gocql.UUID, m Movie, compare func(a, b *Movie) int) {
+	partition := table[key]
+	i := sort.Search(len(partition), func(i int) bool {
+		return compare(&partition[i], &m) >= 0
+	})
+	if i < len(partition) && compare(&partition[i], &m) == 0 {
+		partition[i] = m
+		return
+	}
+	partition = append(partition, Movie{})
+	copy(partition[i+1:], partition[i:])
+	partition[i] = m
+	table[key] = partition
+}
+
+// removeSorted removes the row that compares equal to m from the partition
+// of table at key and reports whether there was one.
+func removeSorted(table map[gocql.UUID][]Movie, key gocql.UUID, m *Movie, compare func(a, b *Movie) int) bool {
+	partition := table[key]
+	i := sort.Search(len(partition), func(i int) bool {
+		return compare(&partition[i], m) >= 0
+	})
+	if i == len(partition) || compare(&partition[i], m) != 0 {
+		return false
+	}
+	partition = append(partition[:i], partition[i+1:]...)
+	if len(partition) == 0 {
+		delete(table, key)
+		return true
+	}
+	table[key] = partition
+	return true
+}
+
+// listMovies walks a movies_by_user partition in clustering order and returns
+// up to page.Size rows accepted by match, starting after the row encoded in
+// page.State. A page size of zero returns every matching row.
+func (s *MemoryStore) listMovies(userID gocql.UUID, page Page, match func(*Movie) bool) ([]Movie, []byte, error) {
+	return s.listPartition(s.movies, userID, compareClustering, page, match)
+}
+
+// listPartition is listMovies for any movie table ordered by compare.
+func (s *MemoryStore) listPartition(table map[gocql.UUID][]Movie, key gocql.UUID, compare func(a, b *Movie) int, page Page, match func(*Movie) bool) ([]Movie, []byte, error) {
+	var after *Movie
+	if len(page.State) > 0 {
+		m, err := decodePagingState(page.State)
+		if err != nil {
+			return nil, nil, err
+		}
+		after = m
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var movies []Movie
+	partition := table[key]
+	for i := range partition {
+		m := &partition[i]
+		if after != nil && compare(m, after) <= 0 {
+			continue
+		}
+		if !match(m) {
+			continue
+		}
+		if page.Size > 0 && len(movies) == page.Size {
+			// There is at least one more row, so hand out a token for it
+			return movies, encodePagingState(&movies[len(movies)-1]), nil
+		}
+		movies = append(movies, *m)
+	}
+
+	return movies, nil, nil
+}
+
+// listByID pages through rows in id order. Its paging state is the id of the
+// last row returned. The caller must hold the read lock.
+func listByID[T any](rows map[gocql.UUID]T, page Page) ([]T, []byte, error) {
+	var after *gocql.UUID
+	if len(page.State) > 0 {
+		id, err := gocql.UUIDFromBytes(page.State)
+		if err != nil {
+			return nil, nil, ErrInvalidPagingState
+		}
+		after = &id
+	}
+
+	ids := make([]gocql.UUID, 0, len(rows))
+	for id := range rows {
+		if after == nil || compareUUID(id, *after) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return compareUUID(ids[i], ids[j]) < 0
+	})
+
+	var next []byte
+	if page.Size > 0 && len(ids) > page.Size {
+		ids = ids[:page.Size]
+		next = ids[len(ids)-1].Bytes()
+	}
+
+	result := make([]T, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, rows[id])
+	}
+	return result, next, nil
+}
+
+// compareClustering orders movies_by_user rows the way the table's
+// CLUSTERING ORDER BY (category_id ASC, created_at DESC, movie_id ASC) does.
+func compareClustering(a, b *Movie) int {
+	if c := compareUUID(a.CategoryID, b.CategoryID); c != 0 {
+		return c
+	}
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	return compareUUID(a.MovieID, b.MovieID)
+}
+
+// compareCategoryClustering orders movies_by_category rows the way the table's
+// partition bucket and CLUSTERING ORDER BY (created_at DESC, movie_id ASC) do.
+func compareCategoryClustering(a, b *Movie) int {
+	if ba, bb := CategoryBucket(a.MovieID), CategoryBucket(b.MovieID); ba != bb {
+		if ba < bb {
+			return -1
+		}
+		return 1
+	}
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	return compareUUID(a.MovieID, b.MovieID)
+}
+
+// compareUUID follows Cassandra's UUID ordering: by version first, then by
+// timestamp for time based UUIDs, and finally by the raw bytes.
+func compareUUID(a, b gocql.UUID) int {
+	if a.Version() != b.Version() {
+		if a.Version() < b.Version() {
+			return -1
+		}
+		return 1
+	}
+	if a.Version() == 1 {
+		if ta, tb := a.Timestamp(), b.Timestamp(); ta != tb {
+			if ta < tb {
+				return -1
+			}
+			return 1
+		}
+	}
+	return bytes.Compare(a[:], b[:])
+}
+
+// A memory paging state is the clustering key of the last row returned:
+// category_id, created_at in unix milliseconds and movie_id.
+const pagingStateLen = 16 + 8 + 16
+
+func encodePagingState(m *Movie) []byte {
+	state := make([]byte, 0, pagingStateLen)
+	state = append(state, m.CategoryID[:]...)
+	state = binary.BigEndian.AppendUint64(state, uint64(m.CreatedAt.UnixMilli()))
+	state = append(state, m.MovieID[:]...)
+	return state
+}
+
+func decodePagingState(state []byte) (*Movie, error) {
+	if len(state) != pagingStateLen {
+		return nil, ErrInvalidPagingState
+	}
+	var m Movie
+	copy(m.CategoryID[:], state[:16])
+	m.CreatedAt = time.UnixMilli(int64(binary.BigEndian.Uint64(state[16:24])))
+	copy(m.MovieID[:], state[24:])
+	return &m, nil
+}
+
+type memoryIdempotencyRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryIdempotencyRepository(store *MemoryStore) IdempotencyRepository {
+	return &memoryIdempotencyRepository{store: store}
+}
+
+func (r *memoryIdempotencyRepository) ClaimKey(ctx context.Context, scope, key string, id gocql.UUID) (gocql.UUID, bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	k := idempotencyKey{scope: scope, key: key}
+	if original, ok := r.store.idempotencyKeys[k]; ok {
+		return original, false, nil
+	}
+	r.store.idempotencyKeys[k] = id
+	return id, true, nil
+}
+
+type memoryAPIKeyRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryAPIKeyRepository(store *MemoryStore) APIKeyRepository {
+	return &memoryAPIKeyRepository{store: store}
+}
+
+func (r *memoryAPIKeyRepository) CreateAPIKey(ctx context.Context, key APIKey) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.apiKeys[key.ID] = key
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) GetAPIKey(ctx context.Context, id gocql.UUID) (*APIKey, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	key, ok := r.store.apiKeys[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &key, nil
+}
+
+func (r *memoryAPIKeyRepository) ListAPIKeys(ctx context.Context, page Page) ([]APIKey, []byte, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	keys, next, err := listByID(r.store.apiKeys, page)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Listing never hands out the hashes, same as the Cassandra repository
+	for i := range keys {
+		keys[i].SecretHash = nil
+	}
+	return keys, next, nil
+}
+
+func (r *memoryAPIKeyRepository) RevokeAPIKey(ctx context.Context, id gocql.UUID, at time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	key, ok := r.store.apiKeys[id]
+	if !ok {
+		return ErrNotFound
+	}
+	key.RevokedAt = at
+	r.store.apiKeys[id] = key
+	return nil
+}
@@ -0,0 +1,373 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+func newMovie(userID, categoryID gocql.UUID, createdAt time.Time, name string) Movie {
+	return Movie{
+		UserID:     userID,
+		MovieID:    gocql.TimeUUID(),
+		CategoryID: categoryID,
+		Name:       name,
+		CreatedAt:  createdAt,
+		UpdatedAt:  createdAt,
+	}
+}
+
+func movieNames(movies []Movie) []string {
+	names := make([]string, 0, len(movies))
+	for _, m := range movies {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func equalNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// listAll pages through list with the given page size and returns every row.
+func listAll[T any](t *testing.T, size int, list func(Page) ([]T, []byte, error)) []T {
+	t.Helper()
+	var all []T
+	var state []byte
+	for pages := 0; ; pages++ {
+		if pages > 100 {
+			t.Fatal("paging does not terminate")
+		}
+		rows, next, err := list(Page{Size: size, State: state})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(rows) > size {
+			t.Fatalf("got %d rows for a page of %d", len(rows), size)
+		}
+		all = append(all, rows...)
+		if next == nil {
+			return all
+		}
+		state = next
+	}
+}
+
+func TestListUsersPages(t *testing.T) {
+	ctx := context.Background()
+	users := NewMemoryUserRepository(NewMemoryStore())
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var want []gocql.UUID
+	for i := range 7 {
+		want = append(want, gocql.UUIDFromTime(base.Add(time.Duration(i)*time.Minute)))
+	}
+	// Insert newest first, listing sorts by id
+	for i := len(want) - 1; i >= 0; i-- {
+		if err := users.CreateUsers(ctx, []User{{ID: want[i], Name: "user"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, size := range []int{1, 3, 7, 10} {
+		got := listAll(t, size, func(p Page) ([]User, []byte, error) {
+			return users.ListUsers(ctx, p)
+		})
+		if len(got) != len(want) {
+			t.Fatalf("page size %d: got %d users, want %d", size, len(got), len(want))
+		}
+		for i := range got {
+			if got[i].ID != want[i] {
+				t.Errorf("page size %d: user %d is %s, want %s", size, i, got[i].ID, want[i])
+			}
+		}
+	}
+
+	if _, _, err := users.ListUsers(ctx, Page{Size: 2, State: []byte("garbage")}); !errors.Is(err, ErrInvalidPagingState) {
+		t.Errorf("got %v for a foreign paging state, want ErrInvalidPagingState", err)
+	}
+}
+
+func TestListMoviesByUserClusteringOrder(t *testing.T) {
+	ctx := context.Background()
+	movies := NewMemoryMovieRepository(NewMemoryStore())
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	userID := gocql.TimeUUID()
+	first := gocql.UUIDFromTime(base)
+	second := gocql.UUIDFromTime(base.Add(time.Hour))
+
+	// Rows come back by category_id, then newest first
+	if err := movies.CreateMovies(ctx, []Movie{
+		newMovie(userID, second, base.Add(1*time.Minute), "second-old"),
+		newMovie(userID, first, base.Add(1*time.Minute), "first-old"),
+		newMovie(userID, second, base.Add(3*time.Minute), "second-new"),
+		newMovie(userID, first, base.Add(2*time.Minute), "first-new"),
+		newMovie(gocql.TimeUUID(), first, base, "other-user"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"first-new", "first-old", "second-new", "second-old"}
+
+	for _, size := range []int{1, 2, 3, 4} {
+		got := listAll(t, size, func(p Page) ([]Movie, []byte, error) {
+			return movies.ListMoviesByUser(ctx, userID, p)
+		})
+		if names := movieNames(got); !equalNames(names, want) {
+			t.Errorf("page size %d: got %v, want %v", size, names, want)
+		}
+	}
+
+	byCategory, err := movies.ListMoviesByUserAndCategory(ctx, userID, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := movieNames(byCategory); !equalNames(names, []string{"second-new", "second-old"}) {
+		t.Errorf("ListMoviesByUserAndCategory got %v", names)
+	}
+
+	if _, _, err := movies.ListMoviesByUser(ctx, userID, Page{Size: 1, State: []byte{1, 2, 3}}); !errors.Is(err, ErrInvalidPagingState) {
+		t.Errorf("got %v for a foreign paging state, want ErrInvalidPagingState", err)
+	}
+}
+
+func TestListMoviesCreatedBetween(t *testing.T) {
+	ctx := context.Background()
+	movies := NewMemoryMovieRepository(NewMemoryStore())
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	userID, categoryID := gocql.TimeUUID(), gocql.TimeUUID()
+	var batch []Movie
+	for i, name := range []string{"jan", "feb", "mar", "apr"} {
+		batch = append(batch, newMovie(userID, categoryID, base.AddDate(0, i, 0), name))
+	}
+	if err := movies.CreateMovies(ctx, batch); err != nil {
+		t.Fatal(err)
+	}
+
+	feb, mar := base.AddDate(0, 1, 0), base.AddDate(0, 2, 0)
+	tests := []struct {
+		name       string
+		start, end *time.Time
+		want       []string
+	}{
+		{"open", nil, nil, []string{"apr", "mar", "feb", "jan"}},
+		{"from february", &feb, nil, []string{"apr", "mar", "feb"}},
+		{"until march", nil, &mar, []string{"mar", "feb", "jan"}},
+		{"february to march", &feb, &mar, []string{"mar", "feb"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := listAll(t, 1, func(p Page) ([]Movie, []byte, error) {
+				return movies.ListMoviesByUserAndCategoryCreatedBetween(ctx, userID, categoryID, tt.start, tt.end, p)
+			})
+			if names := movieNames(got); !equalNames(names, tt.want) {
+				t.Errorf("got %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateMovieMovesCategory(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	movies := NewMemoryMovieRepository(store)
+
+	userID, drama, comedy := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+	previous := newMovie(userID, drama, time.Now(), "Metropolis")
+	if err := movies.CreateMovies(ctx, []Movie{previous}); err != nil {
+		t.Fatal(err)
+	}
+	previous.CreatedAt = previous.CreatedAt.Truncate(time.Millisecond)
+	previous.UpdatedAt = previous.UpdatedAt.Truncate(time.Millisecond)
+
+	moved := previous
+	moved.CategoryID = comedy
+	moved.UpdatedAt = previous.UpdatedAt.Add(time.Second)
+	if err := movies.UpdateMovie(ctx, previous, moved); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every copy of the row follows the move
+	if got, _ := movies.ListMoviesByUserAndCategory(ctx, userID, drama); len(got) != 0 {
+		t.Errorf("movies_by_user still lists %v under the old category", movieNames(got))
+	}
+	if got, _ := movies.ListMoviesByUserAndCategory(ctx, userID, comedy); len(got) != 1 {
+		t.Errorf("movies_by_user lists %d movies under the new category, want 1", len(got))
+	}
+	got, err := movies.GetMovie(ctx, previous.MovieID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.CategoryID != comedy || !got.UpdatedAt.Equal(moved.UpdatedAt) {
+		t.Errorf("movies_by_id has %v", got)
+	}
+	if old, _, _ := movies.ListMoviesByCategory(ctx, drama, Page{}); len(old) != 0 {
+		t.Errorf("movies_by_category still lists %v under the old category", movieNames(old))
+	}
+	if moved, _, _ := movies.ListMoviesByCategory(ctx, comedy, Page{}); len(moved) != 1 {
+		t.Errorf("movies_by_category lists %d movies under the new category, want 1", len(moved))
+	}
+	if _, ok := store.moviesByCategory[drama]; ok {
+		t.Error("empty movies_by_category partition was kept")
+	}
+}
+
+func TestListMoviesByCategoryPages(t *testing.T) {
+	ctx := context.Background()
+	movies := NewMemoryMovieRepository(NewMemoryStore())
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	categoryID := gocql.TimeUUID()
+	var batch []Movie
+	for i := range 40 {
+		// Spread over several users and buckets
+		batch = append(batch, newMovie(gocql.TimeUUID(), categoryID, base.Add(time.Duration(i)*time.Minute), "movie"))
+	}
+	if err := movies.CreateMovies(ctx, batch); err != nil {
+		t.Fatal(err)
+	}
+
+	got := listAll(t, 6, func(p Page) ([]Movie, []byte, error) {
+		return movies.ListMoviesByCategory(ctx, categoryID, p)
+	})
+	if len(got) != len(batch) {
+		t.Fatalf("got %d movies, want %d", len(got), len(batch))
+	}
+	for i := 1; i < len(got); i++ {
+		if compareCategoryClustering(&got[i-1], &got[i]) >= 0 {
+			t.Fatalf("movies %d and %d are out of bucket, created_at DESC order", i-1, i)
+		}
+	}
+}
+
+func TestDeleteMoviesByCategory(t *testing.T) {
+	ctx := context.Background()
+	movies := NewMemoryMovieRepository(NewMemoryStore())
+
+	now := time.Now()
+	userID, drama, comedy := gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()
+	doomed := newMovie(userID, drama, now, "doomed")
+	if err := movies.CreateMovies(ctx, []Movie{
+		doomed,
+		newMovie(userID, drama, now.Add(time.Second), "doomed too"),
+		newMovie(userID, comedy, now, "kept"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := movies.DeleteMoviesByCategory(ctx, userID, drama)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 2 {
+		t.Errorf("deleted %d movies, want 2", deleted)
+	}
+	rest, _, _ := movies.ListMoviesByUser(ctx, userID, Page{})
+	if names := movieNames(rest); !equalNames(names, []string{"kept"}) {
+		t.Errorf("left %v", names)
+	}
+	if _, err := movies.GetMovie(ctx, doomed.MovieID); err != ErrNotFound {
+		t.Errorf("GetMovie of a deleted movie: %v", err)
+	}
+	if left, _, _ := movies.ListMoviesByCategory(ctx, drama, Page{}); len(left) != 0 {
+		t.Errorf("movies_by_category still lists %v", movieNames(left))
+	}
+}
+
+func TestClaimCategoryName(t *testing.T) {
+	ctx := context.Background()
+	categories := NewMemoryCategoryRepository(NewMemoryStore())
+
+	romance, other := gocql.TimeUUID(), gocql.TimeUUID()
+	if holder, err := categories.ClaimCategoryName(ctx, "Romance", romance); err != nil || holder != romance {
+		t.Fatalf("first claim got %s, %v", holder, err)
+	}
+	for _, name := range []string{"romance", " ROMANCE ", "Ｒｏｍａｎｃｅ"} {
+		if holder, _ := categories.ClaimCategoryName(ctx, name, other); holder != romance {
+			t.Errorf("claim of %q got %s, want %s", name, holder, romance)
+		}
+	}
+
+	// Only the holder can release a name
+	if err := categories.ReleaseCategoryName(ctx, "romance", other); err != nil {
+		t.Fatal(err)
+	}
+	if holder, _ := categories.ClaimCategoryName(ctx, "romance", other); holder != romance {
+		t.Errorf("name was released by a category not holding it")
+	}
+	if err := categories.ReleaseCategoryName(ctx, "romance", romance); err != nil {
+		t.Fatal(err)
+	}
+	if holder, _ := categories.ClaimCategoryName(ctx, "romance", other); holder != other {
+		t.Errorf("released name could not be claimed again")
+	}
+}
+
+func TestGetUserMovie(t *testing.T) {
+	ctx := context.Background()
+	movies := NewMemoryMovieRepository(NewMemoryStore())
+
+	owner := gocql.TimeUUID()
+	movie := newMovie(owner, gocql.TimeUUID(), time.Now(), "Metropolis")
+	if err := movies.CreateMovies(ctx, []Movie{movie}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := movies.GetUserMovie(ctx, owner, movie.MovieID)
+	if err != nil || got.Name != "Metropolis" {
+		t.Fatalf("owner got %v, %v", got, err)
+	}
+	if _, err := movies.GetUserMovie(ctx, gocql.TimeUUID(), movie.MovieID); err != ErrNotFound {
+		t.Errorf("another user got %v, want ErrNotFound", err)
+	}
+	if _, err := movies.GetUserMovie(ctx, owner, gocql.TimeUUID()); err != ErrNotFound {
+		t.Errorf("unknown movie got %v, want ErrNotFound", err)
+	}
+}
+
+func TestOrphanMoviesByUser(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	movies := NewMemoryMovieRepository(store)
+
+	owner := gocql.TimeUUID()
+	movie := newMovie(owner, gocql.TimeUUID(), time.Now(), "Metropolis")
+	if err := movies.CreateMovies(ctx, []Movie{movie}); err != nil {
+		t.Fatal(err)
+	}
+	empty := gocql.TimeUUID()
+
+	for _, userID := range []gocql.UUID{owner, empty} {
+		if err := movies.OrphanMoviesByUser(ctx, userID, time.Now()); err != nil {
+			t.Fatalf("OrphanMoviesByUser: %v", err)
+		}
+	}
+
+	if _, ok := store.orphanedAt[owner]; !ok {
+		t.Error("the owner's partition was not stamped")
+	}
+	// Stamping an empty partition would leave a row without a movie behind
+	if _, ok := store.orphanedAt[empty]; ok {
+		t.Error("a user without movies got a partition")
+	}
+	got, _, err := movies.ListMoviesByUser(ctx, owner, Page{Size: 10})
+	if err != nil || !equalNames(movieNames(got), []string{"Metropolis"}) {
+		t.Errorf("got %v, %v, want the orphaned movie kept", movieNames(got), err)
+	}
+	got, _, err = movies.ListMoviesByUser(ctx, empty, Page{Size: 10})
+	if err != nil || len(got) != 0 {
+		t.Errorf("got %v, %v for a user without movies, want none", got, err)
+	}
+}
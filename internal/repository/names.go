@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalizeName returns the form of a category name that uniqueness is
+// checked on. Names that only differ in case, Unicode composition or
+// whitespace normalize to the same string, so "Romance", "ROMANCE" and
+// " romance " all collide.
+func normalizeName(name string) string {
+	folded := cases.Fold().String(norm.NFKC.String(name))
+	return strings.Join(strings.Fields(norm.NFKC.String(folded)), " ")
+}
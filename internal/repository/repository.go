@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+var (
+	// ErrNotFound is returned when a lookup by key matches no row.
+	ErrNotFound = errors.New("repository: not found")
+	// ErrInvalidPagingState is returned when a paging state was not issued by the backend.
+	ErrInvalidPagingState = errors.New("repository: invalid paging state")
+)
+
+type User struct {
+	ID        gocql.UUID
+	Name      string
+	AliasName string
+}
+
+type Category struct {
+	ID          gocql.UUID
+	Name        string
+	Description string
+}
+
+type Movie struct {
+	UserID      gocql.UUID
+	MovieID     gocql.UUID
+	CategoryID  gocql.UUID
+	Name        string
+	BannerURL   string
+	MovieURL    string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type APIKey struct {
+	ID     gocql.UUID
+	Name   string
+	Scopes []string
+	// SecretHash is the SHA-256 of the secret part of the key
+	SecretHash []byte
+	CreatedAt  time.Time
+	// RevokedAt is zero while the key is valid
+	RevokedAt time.Time
+}
+
+// Page selects a slice of a result set. An empty State starts from the beginning.
+type Page struct {
+	Size  int
+	State []byte
+}
+
+type UserRepository interface {
+	CreateUsers(ctx context.Context, users []User) error
+	GetUser(ctx context.Context, id gocql.UUID) (*User, error)
+	UpdateUser(ctx context.Context, user User) error
+	DeleteUser(ctx context.Context, id gocql.UUID) error
+	ListUsers(ctx context.Context, page Page) ([]User, []byte, error)
+}
+
+// CategoryRepository reads and writes the categories table. Category names are
+// unique after case folding and Unicode normalization, the categories_by_name
+// table records which category holds each name.
+type CategoryRepository interface {
+	CreateCategories(ctx context.Context, categories []Category) error
+	GetCategory(ctx context.Context, id gocql.UUID) (*Category, error)
+	ListCategories(ctx context.Context, page Page) ([]Category, []byte, error)
+	// ClaimCategoryName reserves name for the category id before it is
+	// created. It returns the id holding the name, which is id itself unless
+	// another category got there first.
+	ClaimCategoryName(ctx context.Context, name string, id gocql.UUID) (gocql.UUID, error)
+	// ReleaseCategoryName gives back a name reserved for a category that was
+	// never created. It does nothing when id does not hold the name.
+	ReleaseCategoryName(ctx context.Context, name string, id gocql.UUID) error
+	// GetCategoryByName returns ErrNotFound when no category holds the name.
+	GetCategoryByName(ctx context.Context, name string) (*Category, error)
+}
+
+// MovieRepository reads and writes the movies_by_user table and keeps the
+// movies_by_id lookup table and the movies_by_category table in sync with it. The list methods
+// return the rows of the requested page together with the paging state of the
+// next one, which is empty once the result set is exhausted.
+type MovieRepository interface {
+	CreateMovies(ctx context.Context, movies []Movie) error
+	ListMoviesByUserAndCategory(ctx context.Context, userID, categoryID gocql.UUID) ([]Movie, error)
+	ListMoviesByUser(ctx context.Context, userID gocql.UUID, page Page) ([]Movie, []byte, error)
+	ListMoviesByUserAndName(ctx context.Context, userID gocql.UUID, name string, page Page) ([]Movie, []byte, error)
+	// ListMoviesByUserAndCategoryCreatedBetween filters on created_at, a nil
+	// start or end leaves that side of the range open.
+	ListMoviesByUserAndCategoryCreatedBetween(ctx context.Context, userID, categoryID gocql.UUID, start, end *time.Time, page Page) ([]Movie, []byte, error)
+	// ListMoviesByCategory pages through the movies of every user in a
+	// category. Movies are ordered newest first within each bucket of
+	// movies_by_category, not across buckets.
+	ListMoviesByCategory(ctx context.Context, categoryID gocql.UUID, page Page) ([]Movie, []byte, error)
+	// GetMovie looks a movie up through the movies_by_id table and returns
+	// ErrNotFound when there is no movie with that id. Movies written before
+	// movies_by_id existed are only found once the backfill_movies_by_id
+	// migration ran.
+	GetMovie(ctx context.Context, movieID gocql.UUID) (*Movie, error)
+	// GetUserMovie is GetMovie for a movie of userID, a movie of another user
+	// is reported as ErrNotFound. It also finds movies that are missing from
+	// movies_by_id by searching the user's partition.
+	GetUserMovie(ctx context.Context, userID, movieID gocql.UUID) (*Movie, error)
+	// UpdateMovie overwrites previous with movie. When the clustering key
+	// changed, for example a new category_id, the row is moved atomically.
+	UpdateMovie(ctx context.Context, previous, movie Movie) error
+	// DeleteMovie removes the row matching movie's full primary key.
+	DeleteMovie(ctx context.Context, movie Movie) error
+	// DeleteMoviesByCategory removes every movie of a user in one category and
+	// returns how many rows were removed.
+	DeleteMoviesByCategory(ctx context.Context, userID, categoryID gocql.UUID) (int, error)
+	// DeleteMoviesByUser removes a user's whole partition and returns how many
+	// rows were removed.
+	DeleteMoviesByUser(ctx context.Context, userID gocql.UUID) (int, error)
+	// OrphanMoviesByUser keeps a user's movies but stamps the partition with
+	// the time its owner went away.
+	OrphanMoviesByUser(ctx context.Context, userID gocql.UUID, at time.Time) error
+}
+
+// CategoryBuckets is the number of movies_by_category partitions a category
+// is spread over.
+const CategoryBuckets = 16
+
+// CategoryBucket returns the movies_by_category bucket of a movie. It only
+// depends on the movie id, so it never changes over the life of a movie.
+func CategoryBucket(movieID gocql.UUID) int {
+	h := fnv.New32a()
+	h.Write(movieID[:])
+	return int(h.Sum32() % CategoryBuckets)
+}
+
+// IdempotencyRepository remembers the client-supplied key of a create request
+// together with the id that request was given, so a retried request can be
+// answered with the original id instead of creating a duplicate.
+type IdempotencyRepository interface {
+	// ClaimKey stores id under scope and key unless the key is already taken.
+	// It returns the id stored under the key and whether this call stored it.
+	ClaimKey(ctx context.Context, scope, key string, id gocql.UUID) (gocql.UUID, bool, error)
+}
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key APIKey) error
+	GetAPIKey(ctx context.Context, id gocql.UUID) (*APIKey, error)
+	ListAPIKeys(ctx context.Context, page Page) ([]APIKey, []byte, error)
+	// RevokeAPIKey stamps the key with the time it stopped being valid.
+	RevokeAPIKey(ctx context.Context, id gocql.UUID, at time.Time) error
+}
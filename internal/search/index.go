@@ -0,0 +1,225 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+)
+
+// field records which parts of a movie a token appears in
+type field uint8
+
+const (
+	inName field = 1 << iota
+	inDescription
+)
+
+// Index is the embedded search engine, an inverted index from the tokens of
+// every movie's name and description to the movies containing them.
+type Index struct {
+	mu       sync.RWMutex
+	movies   map[gocql.UUID]repository.Movie
+	postings map[string]map[gocql.UUID]field
+	// vocabulary holds the keys of postings in sorted order for prefix
+	// lookups, it is nil after a change and rebuilt by the next search
+	vocabulary []string
+}
+
+func NewIndex() *Index {
+	return &Index{
+		movies:   make(map[gocql.UUID]repository.Movie),
+		postings: make(map[string]map[gocql.UUID]field),
+	}
+}
+
+// Add indexes movies, replacing what was indexed for the same movie ids.
+func (x *Index) Add(movies ...repository.Movie) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for _, m := range movies {
+		x.remove(m.MovieID)
+		// Keep the precision the database stores timestamps with
+		m.CreatedAt = m.CreatedAt.Truncate(time.Millisecond)
+		m.UpdatedAt = m.UpdatedAt.Truncate(time.Millisecond)
+		x.movies[m.MovieID] = m
+		for _, token := range tokenize(m.Name) {
+			x.post(token, m.MovieID, inName)
+		}
+		for _, token := range tokenize(m.Description) {
+			x.post(token, m.MovieID, inDescription)
+		}
+	}
+}
+
+// Remove drops movies from the index.
+func (x *Index) Remove(movieIDs ...gocql.UUID) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for _, id := range movieIDs {
+		x.remove(id)
+	}
+}
+
+// RemoveWhere drops every indexed movie accepted by match.
+func (x *Index) RemoveWhere(match func(*repository.Movie) bool) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for id, m := range x.movies {
+		if match(&m) {
+			x.remove(id)
+		}
+	}
+}
+
+func (x *Index) post(token string, id gocql.UUID, f field) {
+	docs, ok := x.postings[token]
+	if !ok {
+		docs = make(map[gocql.UUID]field)
+		x.postings[token] = docs
+		x.vocabulary = nil
+	}
+	docs[id] |= f
+}
+
+// remove unindexes one movie. The caller must hold the write lock.
+func (x *Index) remove(id gocql.UUID) {
+	m, ok := x.movies[id]
+	if !ok {
+		return
+	}
+	delete(x.movies, id)
+	for _, token := range append(tokenize(m.Name), tokenize(m.Description)...) {
+		docs := x.postings[token]
+		delete(docs, id)
+		if len(docs) == 0 {
+			delete(x.postings, token)
+			x.vocabulary = nil
+		}
+	}
+}
+
+func (x *Index) Search(ctx context.Context, q Query) ([]Result, error) {
+	terms := tokenize(q.Text)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	vocabulary := x.sortedVocabulary()
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	var scores map[gocql.UUID]float64
+	for _, term := range terms {
+		termScores := make(map[gocql.UUID]float64)
+		for _, token := range candidateTokens(vocabulary, term) {
+			weight := matchWeight(term, token)
+			if weight == 0 {
+				continue
+			}
+			docs := x.postings[token]
+			weight *= idf(len(x.movies), len(docs))
+			for id, f := range docs {
+				score := weight * descriptionWeight
+				if f&inName != 0 {
+					score = weight * nameWeight
+				}
+				termScores[id] = max(termScores[id], score)
+			}
+		}
+
+		// Every term has to match, so only movies matched so far stay in
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for id := range scores {
+			if score, ok := termScores[id]; ok {
+				scores[id] += score
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for id, score := range scores {
+		m := x.movies[id]
+		if q.matches(&m) {
+			results = append(results, Result{Movie: m, Score: score})
+		}
+	}
+	return rank(results, q.Limit), nil
+}
+
+// sortedVocabulary returns the tokens of the index in sorted order, sorting
+// them again if the index changed since the last search.
+func (x *Index) sortedVocabulary() []string {
+	x.mu.RLock()
+	vocabulary := x.vocabulary
+	x.mu.RUnlock()
+	if vocabulary != nil {
+		return vocabulary
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.vocabulary == nil {
+		x.vocabulary = make([]string, 0, len(x.postings))
+		for token := range x.postings {
+			x.vocabulary = append(x.vocabulary, token)
+		}
+		sort.Strings(x.vocabulary)
+	}
+	return x.vocabulary
+}
+
+// candidateTokens returns the tokens that may match term. Terms too short for
+// typos only need the range of tokens starting with them, the others have to
+// look at the whole vocabulary.
+func candidateTokens(vocabulary []string, term string) []string {
+	if maxEdits(term) > 0 {
+		return vocabulary
+	}
+	start := sort.SearchStrings(vocabulary, term)
+	end := start
+	for end < len(vocabulary) && strings.HasPrefix(vocabulary[end], term) {
+		end++
+	}
+	return vocabulary[start:end]
+}
+
+// Load indexes every stored movie. It walks the categories rather than the
+// users so movies of deleted users that were kept are found too.
+func (x *Index) Load(ctx context.Context, categories repository.CategoryRepository, movies repository.MovieRepository) error {
+	var categoryState []byte
+	for {
+		page, next, err := categories.ListCategories(ctx, repository.Page{Size: 100, State: categoryState})
+		if err != nil {
+			return err
+		}
+		for _, category := range page {
+			var movieState []byte
+			for {
+				found, next, err := movies.ListMoviesByCategory(ctx, category.ID, repository.Page{Size: 500, State: movieState})
+				if err != nil {
+					return err
+				}
+				x.Add(found...)
+				if len(next) == 0 {
+					break
+				}
+				movieState = next
+			}
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		categoryState = next
+	}
+}
@@ -0,0 +1,205 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+)
+
+// library is a small set of indexed movies for the search tests.
+type library struct {
+	index             *Index
+	ada, grace        gocql.UUID
+	drama, comedy     gocql.UUID
+	metropolis, noir  gocql.UUID
+	nosferatu, safety gocql.UUID
+}
+
+func newLibrary(t *testing.T) *library {
+	t.Helper()
+	l := &library{
+		index: NewIndex(),
+		ada:   gocql.TimeUUID(), grace: gocql.TimeUUID(),
+		drama: gocql.TimeUUID(), comedy: gocql.TimeUUID(),
+		metropolis: gocql.TimeUUID(), noir: gocql.TimeUUID(),
+		nosferatu: gocql.TimeUUID(), safety: gocql.TimeUUID(),
+	}
+	now := time.Now()
+	l.index.Add(
+		repository.Movie{UserID: l.ada, MovieID: l.metropolis, CategoryID: l.drama, Name: "Metropolis", Description: "A city of the future split between workers and planners", CreatedAt: now},
+		repository.Movie{UserID: l.ada, MovieID: l.noir, CategoryID: l.drama, Name: "The Third Man", Description: "A noir set in a divided city", CreatedAt: now.Add(time.Minute)},
+		repository.Movie{UserID: l.grace, MovieID: l.nosferatu, CategoryID: l.drama, Name: "Nosferatu", Description: "A vampire comes to the city", CreatedAt: now.Add(2 * time.Minute)},
+		repository.Movie{UserID: l.grace, MovieID: l.safety, CategoryID: l.comedy, Name: "Safety Last", Description: "A clerk climbs a building", CreatedAt: now.Add(3 * time.Minute)},
+	)
+	return l
+}
+
+// ids returns the movie ids of results in order.
+func ids(results []Result) []gocql.UUID {
+	out := make([]gocql.UUID, len(results))
+	for i, r := range results {
+		out[i] = r.Movie.MovieID
+	}
+	return out
+}
+
+func sameIDs(a, b []gocql.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIndexSearch(t *testing.T) {
+	l := newLibrary(t)
+	tests := []struct {
+		name  string
+		query Query
+		want  []gocql.UUID
+	}{
+		{"empty", Query{Text: " ,. "}, nil},
+		{"exact", Query{Text: "nosferatu"}, []gocql.UUID{l.nosferatu}},
+		{"case", Query{Text: "NOSFERATU"}, []gocql.UUID{l.nosferatu}},
+		{"prefix", Query{Text: "metro"}, []gocql.UUID{l.metropolis}},
+		{"typo", Query{Text: "nosfreatu"}, []gocql.UUID{l.nosferatu}},
+		{"two typos in a long word", Query{Text: "mteropolsi"}, []gocql.UUID{l.metropolis}},
+		{"no typos in short words", Query{Text: "mna"}, nil},
+		{"every word has to match", Query{Text: "city vampire"}, []gocql.UUID{l.nosferatu}},
+		{"unknown word", Query{Text: "city zeppelin"}, nil},
+		{"user filter", Query{Text: "city", UserID: &l.ada}, []gocql.UUID{l.noir, l.metropolis}},
+		{"category filter", Query{Text: "a", CategoryID: &l.comedy}, []gocql.UUID{l.safety}},
+		{"limit", Query{Text: "city", Limit: 1}, []gocql.UUID{l.nosferatu}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := l.index.Search(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+			if !sameIDs(ids(got), tt.want) {
+				t.Errorf("got %v, want %v", ids(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexRanking(t *testing.T) {
+	type doc struct{ name, description string }
+	tests := []struct {
+		name  string
+		query string
+		docs  []doc
+		// want is the order of the matching docs, by index into docs
+		want []int
+	}{
+		{"name over description", "harbor", []doc{{"Untitled", "harbor"}, {"Harbor", ""}}, []int{1, 0}},
+		{"exact over prefix", "harbor", []doc{{"Harborside", ""}, {"Harbor", ""}}, []int{1, 0}},
+		{"prefix over typo", "harbor", []doc{{"Harbour", ""}, {"Harbors", ""}}, []int{1, 0}},
+		{"newer wins ties", "harbor", []doc{{"Harbor", ""}, {"Harbor", ""}}, []int{1, 0}},
+		// Both match both words, but the first has the rarer one in its name
+		{"rare words weigh more", "harbor city", []doc{
+			{"Harbor", "city"}, {"City", "harbor"}, {"City", ""}, {"City", ""}, {"City", ""},
+		}, []int{0, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := NewIndex()
+			movieIDs := make([]gocql.UUID, len(tt.docs))
+			now := time.Now()
+			for i, d := range tt.docs {
+				movieIDs[i] = gocql.TimeUUID()
+				index.Add(repository.Movie{MovieID: movieIDs[i], Name: d.name, Description: d.description, CreatedAt: now.Add(time.Duration(i) * time.Second)})
+			}
+			got, err := index.Search(context.Background(), Query{Text: tt.query})
+			if err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+			want := make([]gocql.UUID, len(tt.want))
+			for i, j := range tt.want {
+				want[i] = movieIDs[j]
+			}
+			if !sameIDs(ids(got), want) {
+				t.Errorf("got %v, want %v", ids(got), want)
+			}
+		})
+	}
+}
+
+func TestIndexRemove(t *testing.T) {
+	l := newLibrary(t)
+	search := func(text string) []gocql.UUID {
+		t.Helper()
+		got, err := l.index.Search(context.Background(), Query{Text: text})
+		if err != nil {
+			t.Fatalf("Search: %v", err)
+		}
+		return ids(got)
+	}
+
+	l.index.Remove(l.nosferatu)
+	if got := search("vampire"); len(got) != 0 {
+		t.Errorf("got %v after removing the only vampire", got)
+	}
+
+	l.index.RemoveWhere(func(m *repository.Movie) bool { return m.UserID == l.ada })
+	if got := search("city"); len(got) != 0 {
+		t.Errorf("got %v after removing every city movie", got)
+	}
+
+	// Indexing a movie again replaces its old words
+	l.index.Add(repository.Movie{UserID: l.grace, MovieID: l.safety, CategoryID: l.comedy, Name: "Speedy"})
+	if got := search("clerk"); len(got) != 0 {
+		t.Errorf("got %v for a replaced description", got)
+	}
+	if got := search("speedy"); !sameIDs(got, []gocql.UUID{l.safety}) {
+		t.Errorf("got %v, want %s", got, l.safety)
+	}
+}
+
+func TestIndexedMovieRepository(t *testing.T) {
+	store := repository.NewMemoryStore()
+	index := NewIndex()
+	movies := NewIndexedMovieRepository(repository.NewMemoryMovieRepository(store), index)
+	ctx := context.Background()
+
+	userID, categoryID := gocql.TimeUUID(), gocql.TimeUUID()
+	m := repository.Movie{UserID: userID, MovieID: gocql.TimeUUID(), CategoryID: categoryID, Name: "Metropolis", CreatedAt: time.Now()}
+	if err := movies.CreateMovies(ctx, []repository.Movie{m}); err != nil {
+		t.Fatalf("CreateMovies: %v", err)
+	}
+	count := func(text string) int {
+		t.Helper()
+		got, err := index.Search(ctx, Query{Text: text})
+		if err != nil {
+			t.Fatalf("Search: %v", err)
+		}
+		return len(got)
+	}
+	if count("metropolis") != 1 {
+		t.Fatal("created movie is not indexed")
+	}
+
+	renamed := m
+	renamed.Name = "Nosferatu"
+	if err := movies.UpdateMovie(ctx, m, renamed); err != nil {
+		t.Fatalf("UpdateMovie: %v", err)
+	}
+	if count("metropolis") != 0 || count("nosferatu") != 1 {
+		t.Error("update is not reflected in the index")
+	}
+
+	if _, err := movies.DeleteMoviesByUser(ctx, userID); err != nil {
+		t.Fatalf("DeleteMoviesByUser: %v", err)
+	}
+	if count("nosferatu") != 0 {
+		t.Error("deleted movie is still indexed")
+	}
+}
@@ -0,0 +1,67 @@
+package search
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+)
+
+// indexedMovieRepository passes every write on to the wrapped repository and
+// updates the index once the write succeeded.
+type indexedMovieRepository struct {
+	repository.MovieRepository
+	index *Index
+}
+
+// NewIndexedMovieRepository keeps index in sync with the movies written
+// through the returned repository.
+func NewIndexedMovieRepository(movies repository.MovieRepository, index *Index) repository.MovieRepository {
+	return &indexedMovieRepository{MovieRepository: movies, index: index}
+}
+
+func (r *indexedMovieRepository) CreateMovies(ctx context.Context, movies []repository.Movie) error {
+	if err := r.MovieRepository.CreateMovies(ctx, movies); err != nil {
+		return err
+	}
+	r.index.Add(movies...)
+	return nil
+}
+
+func (r *indexedMovieRepository) UpdateMovie(ctx context.Context, previous, movie repository.Movie) error {
+	if err := r.MovieRepository.UpdateMovie(ctx, previous, movie); err != nil {
+		return err
+	}
+	r.index.Add(movie)
+	return nil
+}
+
+func (r *indexedMovieRepository) DeleteMovie(ctx context.Context, movie repository.Movie) error {
+	if err := r.MovieRepository.DeleteMovie(ctx, movie); err != nil {
+		return err
+	}
+	r.index.Remove(movie.MovieID)
+	return nil
+}
+
+func (r *indexedMovieRepository) DeleteMoviesByCategory(ctx context.Context, userID, categoryID gocql.UUID) (int, error) {
+	deleted, err := r.MovieRepository.DeleteMoviesByCategory(ctx, userID, categoryID)
+	if err != nil {
+		return 0, err
+	}
+	r.index.RemoveWhere(func(m *repository.Movie) bool {
+		return m.UserID == userID && m.CategoryID == categoryID
+	})
+	return deleted, nil
+}
+
+func (r *indexedMovieRepository) DeleteMoviesByUser(ctx context.Context, userID gocql.UUID) (int, error) {
+	deleted, err := r.MovieRepository.DeleteMoviesByUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	r.index.RemoveWhere(func(m *repository.Movie) bool {
+		return m.UserID == userID
+	})
+	return deleted, nil
+}
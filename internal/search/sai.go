@@ -0,0 +1,165 @@
+package search
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/database"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+)
+
+// candidateLimit bounds the rows one index lookup returns for ranking.
+const candidateLimit = 200
+
+// maxGramSize is the longest prefix the indexes hold of a word, see
+// 0012_search_index_grams. Longer query terms are looked up by their first
+// maxGramSize letters and checked in full when ranking.
+const maxGramSize = 40
+
+// saiEngine searches movies_by_category through the analyzed SAI indexes on
+// name and description. Their analyzers lower case and edge n-gram the text,
+// which gives case-insensitive token and prefix matches. Unlike the embedded
+// engine it does not find words with a typo: the indexes only return words
+// starting with the query term, typos only affect how those are ranked.
+type saiEngine struct {
+	session database.SessionSource
+}
+
+func NewSAIEngine(session database.SessionSource) Engine {
+	return &saiEngine{session: session}
+}
+
+func (e *saiEngine) Search(ctx context.Context, q Query) ([]Result, error) {
+	terms := tokenize(q.Text)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	// Look every term up in both indexes, a movie has to be found for each
+	var candidates map[gocql.UUID]repository.Movie
+	for _, term := range terms {
+		found := make(map[gocql.UUID]repository.Movie)
+		for _, column := range []string{"name", "description"} {
+			if err := e.lookup(ctx, column, term, &q, found); err != nil {
+				return nil, err
+			}
+		}
+		// A term longer than the indexed prefixes also found words that
+		// only share its beginning
+		if len([]rune(term)) > maxGramSize {
+			for id, m := range found {
+				if !containsMatch(term, &m) {
+					delete(found, id)
+				}
+			}
+		}
+
+		if candidates == nil {
+			candidates = found
+			continue
+		}
+		for id := range candidates {
+			if _, ok := found[id]; !ok {
+				delete(candidates, id)
+			}
+		}
+	}
+
+	results := make([]Result, 0, len(candidates))
+	for _, m := range candidates {
+		if !q.matches(&m) {
+			continue
+		}
+		var score float64
+		for _, term := range terms {
+			score += termScore(term, &m)
+		}
+		results = append(results, Result{Movie: m, Score: score})
+	}
+	return rank(results, q.Limit), nil
+}
+
+// lookup adds the movies whose column matches term to found, at most
+// candidateLimit of them. The user and category filters are part of the
+// query, so the limit only counts movies that pass them.
+func (e *saiEngine) lookup(ctx context.Context, column, term string, q *Query, found map[gocql.UUID]repository.Movie) error {
+	where, args := lookupFilter(column, term, q)
+	if q.CategoryID == nil {
+		_, err := e.scan(ctx, where, args, candidateLimit, found)
+		return err
+	}
+
+	// A category is read one bucket partition after the other until the
+	// limit is reached
+	n := 0
+	for bucket := 0; bucket < repository.CategoryBuckets && n < candidateLimit; bucket++ {
+		bucketWhere, bucketArgs := inBucket(where, args, *q.CategoryID, bucket)
+		got, err := e.scan(ctx, bucketWhere, bucketArgs, candidateLimit-n, found)
+		if err != nil {
+			return err
+		}
+		n += got
+	}
+	return nil
+}
+
+// lookupFilter returns the WHERE clause matching term in column, restricted
+// to the user of q when set.
+func lookupFilter(column, term string, q *Query) (string, []interface{}) {
+	if runes := []rune(term); len(runes) > maxGramSize {
+		term = string(runes[:maxGramSize])
+	}
+	where := column + ` : ?`
+	args := []interface{}{term}
+	if q.UserID != nil {
+		where += ` AND user_id = ?`
+		args = append(args, *q.UserID)
+	}
+	return where, args
+}
+
+// inBucket restricts where to one bucket partition of a category.
+func inBucket(where string, args []interface{}, categoryID gocql.UUID, bucket int) (string, []interface{}) {
+	return `category_id = ? AND bucket = ? AND ` + where, append([]interface{}{categoryID, bucket}, args...)
+}
+
+// scan adds up to limit movies_by_category rows matching where to found and
+// returns how many it read.
+func (e *saiEngine) scan(ctx context.Context, where string, args []interface{}, limit int, found map[gocql.UUID]repository.Movie) (int, error) {
+	stmt := `SELECT user_id, movie_id, category_id, name, banner_url, movie_url, description, created_at, updated_at FROM movies_by_category WHERE ` + where + ` LIMIT ?`
+	iter := e.session.Session().Query(stmt, append(args, limit)...).WithContext(ctx).Iter()
+	var (
+		m repository.Movie
+		n int
+	)
+	for iter.Scan(&m.UserID, &m.MovieID, &m.CategoryID, &m.Name, &m.BannerURL, &m.MovieURL, &m.Description, &m.CreatedAt, &m.UpdatedAt) {
+		found[m.MovieID] = m
+		n++
+	}
+	return n, iter.Close()
+}
+
+// containsMatch reports whether any word of m matches term.
+func containsMatch(term string, m *repository.Movie) bool {
+	for _, token := range append(tokenize(m.Name), tokenize(m.Description)...) {
+		if matchWeight(term, token) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// termScore scores one query term against a movie returned by the indexes.
+// Without index statistics every token counts the same, and a row the
+// analyzer matched in a way the tokenizer does not see still gets the lowest
+// score rather than none.
+func termScore(term string, m *repository.Movie) float64 {
+	best := fuzzyMatch * descriptionWeight
+	for _, token := range tokenize(m.Name) {
+		best = max(best, matchWeight(term, token)*nameWeight)
+	}
+	for _, token := range tokenize(m.Description) {
+		best = max(best, matchWeight(term, token)*descriptionWeight)
+	}
+	return best
+}
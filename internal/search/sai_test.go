@@ -0,0 +1,61 @@
+package search
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+)
+
+func TestLookupFilter(t *testing.T) {
+	userID := gocql.TimeUUID()
+	long := strings.Repeat("a", maxGramSize+5)
+
+	tests := []struct {
+		name      string
+		term      string
+		q         Query
+		wantWhere string
+		wantArgs  []interface{}
+	}{
+		{"term", "noir", Query{}, `name : ?`, []interface{}{"noir"}},
+		{"user", "noir", Query{UserID: &userID}, `name : ? AND user_id = ?`, []interface{}{"noir", userID}},
+		{"long term", long, Query{}, `name : ?`, []interface{}{long[:maxGramSize]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, args := lookupFilter("name", tt.term, &tt.q)
+			if where != tt.wantWhere || !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("got %q %v, want %q %v", where, args, tt.wantWhere, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestInBucket(t *testing.T) {
+	userID, categoryID := gocql.TimeUUID(), gocql.TimeUUID()
+	where, args := lookupFilter("description", "noir", &Query{UserID: &userID})
+	where, args = inBucket(where, args, categoryID, 3)
+
+	// The partition key comes first so the lookup stays in one partition
+	wantWhere := `category_id = ? AND bucket = ? AND description : ? AND user_id = ?`
+	wantArgs := []interface{}{categoryID, 3, "noir", userID}
+	if where != wantWhere || !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("got %q %v, want %q %v", where, args, wantWhere, wantArgs)
+	}
+}
+
+func TestContainsMatch(t *testing.T) {
+	long := strings.Repeat("x", maxGramSize) + "yz"
+	m := repository.Movie{Name: "Words", Description: "ends with " + long + "zz"}
+
+	if !containsMatch(long, &m) {
+		t.Error("a prefix of a long word does not match")
+	}
+	// Shares the indexed prefix but not the rest
+	if containsMatch(strings.Repeat("x", maxGramSize)+"abcd", &m) {
+		t.Error("a word only sharing the indexed prefix matches")
+	}
+}
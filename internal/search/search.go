@@ -0,0 +1,163 @@
+// Package search finds movies by the words in their name and description.
+//
+// Two engines are available. The embedded engine keeps an inverted index in
+// process memory and is fed by wrapping the movie repository with
+// NewIndexedMovieRepository, so it only sees the writes of its own server
+// process and suits a single instance. The SAI engine asks Astra's analyzed
+// storage-attached indexes for candidates and ranks them in process, it needs
+// no syncing but only finds what the analyzers index.
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Query describes a search. Every word of Text has to match a movie for it
+// to be returned, a word matches a token that equals it, starts with it or is
+// within a small edit distance of it. The SAI engine only finds the first
+// two.
+type Query struct {
+	Text string
+	// UserID and CategoryID restrict the results when set
+	UserID     *gocql.UUID
+	CategoryID *gocql.UUID
+	Limit      int
+}
+
+type Result struct {
+	Movie repository.Movie
+	Score float64
+}
+
+type Engine interface {
+	// Search returns at most q.Limit movies, best match first.
+	Search(ctx context.Context, q Query) ([]Result, error)
+}
+
+// How much a matching token contributes, by where it was found and how
+const (
+	nameWeight        = 2.0
+	descriptionWeight = 1.0
+
+	exactMatch  = 1.0
+	prefixMatch = 0.7
+	fuzzyMatch  = 0.5
+)
+
+// tokenize splits text into lower case, NFKC normalized words.
+func tokenize(text string) []string {
+	folded := cases.Fold().String(norm.NFKC.String(text))
+	return strings.FieldsFunc(folded, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// matchWeight reports how well a token of a movie matches a query term, zero
+// means it does not match at all.
+func matchWeight(term, token string) float64 {
+	switch {
+	case token == term:
+		return exactMatch
+	case strings.HasPrefix(token, term):
+		return prefixMatch
+	case withinEdits(term, token, maxEdits(term)):
+		return fuzzyMatch
+	}
+	return 0
+}
+
+// maxEdits allows more typos in longer words, short words have to be exact.
+func maxEdits(term string) int {
+	switch n := len([]rune(term)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// withinEdits reports whether a and b are at most k edits apart. An edit
+// inserts, deletes or replaces one letter or swaps two adjacent ones.
+func withinEdits(a, b string, k int) bool {
+	if k == 0 {
+		return a == b
+	}
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > k || -d > k {
+		return false
+	}
+
+	// Optimal string alignment distance, keeping the last three rows
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > k {
+			return false
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(rb)] <= k
+}
+
+// idf weighs a token by how rare it is among n movies, df of which contain it.
+func idf(n, df int) float64 {
+	return math.Log(1 + float64(n)/float64(max(df, 1)))
+}
+
+// matches reports whether m passes the user and category filters of q.
+func (q *Query) matches(m *repository.Movie) bool {
+	if q.UserID != nil && m.UserID != *q.UserID {
+		return false
+	}
+	if q.CategoryID != nil && m.CategoryID != *q.CategoryID {
+		return false
+	}
+	return true
+}
+
+// rank orders results best first, newer movies win ties, and keeps at most
+// limit of them.
+func rank(results []Result, limit int) []Result {
+	sort.Slice(results, func(i, j int) bool {
+		a, b := &results[i], &results[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if !a.Movie.CreatedAt.Equal(b.Movie.CreatedAt) {
+			return a.Movie.CreatedAt.After(b.Movie.CreatedAt)
+		}
+		return a.Movie.MovieID.String() < b.Movie.MovieID.String()
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
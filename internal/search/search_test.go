@@ -0,0 +1,112 @@
+package search
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/movie-project-grpc/internal/repository"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", nil},
+		{"Metropolis", []string{"metropolis"}},
+		{"  The Third-Man, 1949! ", []string{"the", "third", "man", "1949"}},
+		{"STRASSE Straße", []string{"strasse", "strasse"}},
+		{"ｆｕｌｌ width", []string{"full", "width"}},
+		{"Amélie", []string{"amélie"}},
+	}
+	for _, tt := range tests {
+		if got := tokenize(tt.text); !slices.Equal(got, tt.want) {
+			t.Errorf("tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestWithinEdits(t *testing.T) {
+	tests := []struct {
+		a, b string
+		k    int
+		want bool
+	}{
+		{"metropolis", "metropolis", 0, true},
+		{"metropolis", "metropoli", 0, false},
+		{"metropolis", "metropoli", 1, true},
+		{"metropolis", "metrXpolis", 1, true},
+		{"metropolis", "metorpolis", 1, true},
+		{"metropolis", "metropolisss", 1, false},
+		{"metropolis", "mteorpolis", 1, false},
+		{"metropolis", "mteorpolis", 2, true},
+		{"ca", "abc", 2, false},
+		{"nosferatu", "nosfreatu", 1, true},
+		{"amélie", "amelie", 1, true},
+		{"", "ab", 2, true},
+		{"", "abc", 2, false},
+	}
+	for _, tt := range tests {
+		if got := withinEdits(tt.a, tt.b, tt.k); got != tt.want {
+			t.Errorf("withinEdits(%q, %q, %d) = %t, want %t", tt.a, tt.b, tt.k, got, tt.want)
+		}
+	}
+}
+
+func TestMatchWeight(t *testing.T) {
+	tests := []struct {
+		term, token string
+		want        float64
+	}{
+		{"noir", "noir", exactMatch},
+		{"no", "noir", prefixMatch},
+		{"noir", "noire", prefixMatch},
+		{"nior", "noir", fuzzyMatch},
+		{"cat", "cut", 0},
+		{"metropolis", "metorpolsi", fuzzyMatch},
+		{"metropolis", "megalopolis", 0},
+		{"noir", "film", 0},
+	}
+	for _, tt := range tests {
+		if got := matchWeight(tt.term, tt.token); got != tt.want {
+			t.Errorf("matchWeight(%q, %q) = %g, want %g", tt.term, tt.token, got, tt.want)
+		}
+	}
+}
+
+func TestIDF(t *testing.T) {
+	if rare, common := idf(100, 1), idf(100, 50); rare <= common {
+		t.Errorf("idf of a rare token %g is not above a common one %g", rare, common)
+	}
+	if idf(10, 0) != idf(10, 1) {
+		t.Error("idf of an unknown token differs from one seen once")
+	}
+}
+
+func TestRank(t *testing.T) {
+	now := time.Now()
+	ids := []gocql.UUID{gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID(), gocql.TimeUUID()}
+	results := []Result{
+		{Movie: repository.Movie{MovieID: ids[0], CreatedAt: now}, Score: 1},
+		{Movie: repository.Movie{MovieID: ids[1], CreatedAt: now.Add(time.Hour)}, Score: 1},
+		{Movie: repository.Movie{MovieID: ids[2], CreatedAt: now}, Score: 3},
+		{Movie: repository.Movie{MovieID: ids[3], CreatedAt: now}, Score: 0.5},
+	}
+
+	got := rank(slices.Clone(results), 3)
+	want := []gocql.UUID{ids[2], ids[1], ids[0]}
+	if len(got) != len(want) {
+		t.Fatalf("got %d results, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Movie.MovieID != want[i] {
+			t.Errorf("result %d is %s, want %s", i, got[i].Movie.MovieID, want[i])
+		}
+	}
+
+	if got := rank(slices.Clone(results), 0); len(got) != len(results) {
+		t.Errorf("got %d results without a limit, want %d", len(got), len(results))
+	}
+}
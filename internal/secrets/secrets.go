@@ -0,0 +1,120 @@
+// Package secrets reads credentials from a configurable source. Sources are
+// read again on every call so a rotated secret is picked up without a
+// restart.
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+type Provider interface {
+	// Secret returns the current value of the secret.
+	Secret(ctx context.Context) (string, error)
+}
+
+// Parse builds a provider from a source of the form:
+//
+//	env:NAME        the environment variable NAME
+//	file:PATH       the contents of PATH, such as a Kubernetes secret mount
+//	command:LINE    the standard output of LINE run by sh -c
+//
+// Surrounding whitespace, including the trailing newline most files and
+// commands end with, is not part of the secret.
+func Parse(source string) (Provider, error) {
+	kind, value, ok := strings.Cut(source, ":")
+	if !ok || value == "" {
+		return nil, fmt.Errorf("secrets: source %q is not of the form env:NAME, file:PATH or command:LINE", source)
+	}
+	switch kind {
+	case "env":
+		return NewEnvProvider(value), nil
+	case "file":
+		return NewFileProvider(value), nil
+	case "command":
+		return NewCommandProvider(value), nil
+	default:
+		return nil, fmt.Errorf("secrets: unknown source kind %q", kind)
+	}
+}
+
+type envProvider struct {
+	name string
+}
+
+func NewEnvProvider(name string) Provider {
+	return &envProvider{name: name}
+}
+
+func (p *envProvider) Secret(ctx context.Context) (string, error) {
+	value := strings.TrimSpace(os.Getenv(p.name))
+	if value == "" {
+		return "", fmt.Errorf("secrets: environment variable %s is not set", p.name)
+	}
+	return value, nil
+}
+
+type fileProvider struct {
+	path string
+}
+
+func NewFileProvider(path string) Provider {
+	return &fileProvider{path: path}
+}
+
+func (p *fileProvider) Secret(ctx context.Context) (string, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return "", fmt.Errorf("secrets: %w", err)
+	}
+	value := strings.TrimSpace(string(data))
+	if value == "" {
+		return "", fmt.Errorf("secrets: %s is empty", p.path)
+	}
+	return value, nil
+}
+
+type commandProvider struct {
+	command string
+}
+
+func NewCommandProvider(command string) Provider {
+	return &commandProvider{command: command}
+}
+
+func (p *commandProvider) Secret(ctx context.Context) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "sh", "-c", p.command)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		// stderr may help, stdout may hold part of the secret so it is left out
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("secrets: command failed: %w: %s", err, msg)
+		}
+		return "", fmt.Errorf("secrets: command failed: %w", err)
+	}
+	value := strings.TrimSpace(stdout.String())
+	if value == "" {
+		return "", fmt.Errorf("secrets: command printed nothing")
+	}
+	return value, nil
+}
+
+type staticProvider struct {
+	value string
+}
+
+// NewStaticProvider always returns value, even an empty one, for secrets that
+// were read once at startup.
+func NewStaticProvider(value string) Provider {
+	return &staticProvider{value: value}
+}
+
+func (p *staticProvider) Secret(ctx context.Context) (string, error) {
+	return p.value, nil
+}
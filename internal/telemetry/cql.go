@@ -0,0 +1,97 @@
+package telemetry
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/yaninyzwitty/movie-project-grpc/internal/telemetry"
+
+// CQLTracer records a span for every query and batch gocql sends, as a child
+// of the span in the query's context. gocql reports a query once it
+// returned, so the spans are back-dated to when it started. Every page of a
+// paged query and every retry gets its own span. Bound values are never
+// recorded, they can hold personal data.
+type CQLTracer struct {
+	tracer trace.Tracer
+}
+
+// NewCQLTracer uses the global tracer provider, call it after Setup.
+func NewCQLTracer() *CQLTracer {
+	return &CQLTracer{tracer: otel.Tracer(instrumentationName)}
+}
+
+func (t *CQLTracer) ObserveQuery(ctx context.Context, q gocql.ObservedQuery) {
+	operation := operationName(q.Statement)
+	_, span := t.tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(q.Start),
+		trace.WithAttributes(
+			semconv.DBSystemCassandra,
+			semconv.DBOperationName(operation),
+			semconv.DBNamespace(q.Keyspace),
+			semconv.DBQueryText(q.Statement),
+			attribute.Int("db.response.returned_rows", q.Rows),
+			attribute.Int("db.cassandra.attempt", q.Attempt),
+		),
+	)
+	end(span, q.Host, q.Err, q.End)
+}
+
+func (t *CQLTracer) ObserveBatch(ctx context.Context, b gocql.ObservedBatch) {
+	_, span := t.tracer.Start(ctx, "BATCH",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(b.Start),
+		trace.WithAttributes(
+			semconv.DBSystemCassandra,
+			semconv.DBOperationName("BATCH"),
+			semconv.DBNamespace(b.Keyspace),
+			semconv.DBQueryText(batchText(b.Statements)),
+			attribute.Int("db.operation.batch.size", len(b.Statements)),
+			attribute.Int("db.cassandra.attempt", b.Attempt),
+		),
+	)
+	end(span, b.Host, b.Err, b.End)
+}
+
+func end(span trace.Span, host *gocql.HostInfo, err error, at time.Time) {
+	if host != nil {
+		span.SetAttributes(semconv.ServerAddress(host.ConnectAddress().String()), semconv.ServerPort(host.Port()))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(at))
+}
+
+// operationName returns the CQL command of a statement, such as SELECT.
+func operationName(statement string) string {
+	fields := strings.Fields(statement)
+	if len(fields) == 0 {
+		return "CQL"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+// batchText lists the distinct statements of a batch, a batch usually
+// repeats a few statements with different values.
+func batchText(statements []string) string {
+	seen := make(map[string]bool, len(statements))
+	var distinct []string
+	for _, stmt := range statements {
+		if !seen[stmt] {
+			seen[stmt] = true
+			distinct = append(distinct, stmt)
+		}
+	}
+	return strings.Join(distinct, ";\n")
+}
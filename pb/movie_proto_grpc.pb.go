@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	UserService_CreateUsers_FullMethodName = "/moviebase.UserService/CreateUsers"
 	UserService_GetUser_FullMethodName     = "/moviebase.UserService/GetUser"
+	UserService_UpdateUser_FullMethodName  = "/moviebase.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName  = "/moviebase.UserService/DeleteUser"
+	UserService_ListUsers_FullMethodName   = "/moviebase.UserService/ListUsers"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -29,6 +32,9 @@ const (
 type UserServiceClient interface {
 	CreateUsers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CreateUserRequest, CreateUsersResponse], error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListUsersResponse], error)
 }
 
 type userServiceClient struct {
@@ -62,12 +68,54 @@ func (c *userServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opt
 	return out, nil
 }
 
+func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListUsersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[1], UserService_ListUsers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListUsersRequest, ListUsersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ListUsersClient = grpc.ServerStreamingClient[ListUsersResponse]
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 type UserServiceServer interface {
 	CreateUsers(grpc.ClientStreamingServer[CreateUserRequest, CreateUsersResponse]) error
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	ListUsers(*ListUsersRequest, grpc.ServerStreamingServer[ListUsersResponse]) error
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -84,6 +132,15 @@ func (UnimplementedUserServiceServer) CreateUsers(grpc.ClientStreamingServer[Cre
 func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(*ListUsersRequest, grpc.ServerStreamingServer[ListUsersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -130,6 +187,53 @@ func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).ListUsers(m, &grpc.GenericServerStream[ListUsersRequest, ListUsersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ListUsersServer = grpc.ServerStreamingServer[ListUsersResponse]
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -141,6 +245,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUser",
 			Handler:    _UserService_GetUser_Handler,
 		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -148,12 +260,20 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _UserService_CreateUsers_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ListUsers",
+			Handler:       _UserService_ListUsers_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "movie_proto.proto",
 }
 
 const (
-	CategoryService_CreateCategories_FullMethodName = "/moviebase.CategoryService/CreateCategories"
+	CategoryService_CreateCategories_FullMethodName  = "/moviebase.CategoryService/CreateCategories"
+	CategoryService_GetCategory_FullMethodName       = "/moviebase.CategoryService/GetCategory"
+	CategoryService_GetCategoryByName_FullMethodName = "/moviebase.CategoryService/GetCategoryByName"
+	CategoryService_ListCategories_FullMethodName    = "/moviebase.CategoryService/ListCategories"
 )
 
 // CategoryServiceClient is the client API for CategoryService service.
@@ -161,6 +281,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CategoryServiceClient interface {
 	CreateCategories(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CreateCategoryRequest, CreateCategoriesResponse], error)
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error)
+	GetCategoryByName(ctx context.Context, in *GetCategoryByNameRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListCategoriesResponse], error)
 }
 
 type categoryServiceClient struct {
@@ -184,11 +307,53 @@ func (c *categoryServiceClient) CreateCategories(ctx context.Context, opts ...gr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type CategoryService_CreateCategoriesClient = grpc.ClientStreamingClient[CreateCategoryRequest, CreateCategoriesResponse]
 
+func (c *categoryServiceClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryResponse)
+	err := c.cc.Invoke(ctx, CategoryService_GetCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) GetCategoryByName(ctx context.Context, in *GetCategoryByNameRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryResponse)
+	err := c.cc.Invoke(ctx, CategoryService_GetCategoryByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListCategoriesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CategoryService_ServiceDesc.Streams[1], CategoryService_ListCategories_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListCategoriesRequest, ListCategoriesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CategoryService_ListCategoriesClient = grpc.ServerStreamingClient[ListCategoriesResponse]
+
 // CategoryServiceServer is the server API for CategoryService service.
 // All implementations must embed UnimplementedCategoryServiceServer
 // for forward compatibility.
 type CategoryServiceServer interface {
 	CreateCategories(grpc.ClientStreamingServer[CreateCategoryRequest, CreateCategoriesResponse]) error
+	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
+	GetCategoryByName(context.Context, *GetCategoryByNameRequest) (*GetCategoryResponse, error)
+	ListCategories(*ListCategoriesRequest, grpc.ServerStreamingServer[ListCategoriesResponse]) error
 	mustEmbedUnimplementedCategoryServiceServer()
 }
 
@@ -202,6 +367,15 @@ type UnimplementedCategoryServiceServer struct{}
 func (UnimplementedCategoryServiceServer) CreateCategories(grpc.ClientStreamingServer[CreateCategoryRequest, CreateCategoriesResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method CreateCategories not implemented")
 }
+func (UnimplementedCategoryServiceServer) GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) GetCategoryByName(context.Context, *GetCategoryByNameRequest) (*GetCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryByName not implemented")
+}
+func (UnimplementedCategoryServiceServer) ListCategories(*ListCategoriesRequest, grpc.ServerStreamingServer[ListCategoriesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
 func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
 func (UnimplementedCategoryServiceServer) testEmbeddedByValue()                         {}
 
@@ -230,29 +404,97 @@ func _CategoryService_CreateCategories_Handler(srv interface{}, stream grpc.Serv
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type CategoryService_CreateCategoriesServer = grpc.ClientStreamingServer[CreateCategoryRequest, CreateCategoriesResponse]
 
+func _CategoryService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_GetCategoryByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).GetCategoryByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_GetCategoryByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).GetCategoryByName(ctx, req.(*GetCategoryByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_ListCategories_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCategoriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CategoryServiceServer).ListCategories(m, &grpc.GenericServerStream[ListCategoriesRequest, ListCategoriesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CategoryService_ListCategoriesServer = grpc.ServerStreamingServer[ListCategoriesResponse]
+
 // CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var CategoryService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "moviebase.CategoryService",
 	HandlerType: (*CategoryServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCategory",
+			Handler:    _CategoryService_GetCategory_Handler,
+		},
+		{
+			MethodName: "GetCategoryByName",
+			Handler:    _CategoryService_GetCategoryByName_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "CreateCategories",
 			Handler:       _CategoryService_CreateCategories_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ListCategories",
+			Handler:       _CategoryService_ListCategories_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "movie_proto.proto",
 }
 
 const (
 	MovieService_CreateMovies_FullMethodName                              = "/moviebase.MovieService/CreateMovies"
+	MovieService_CreateMoviesBidi_FullMethodName                          = "/moviebase.MovieService/CreateMoviesBidi"
 	MovieService_GetMoviesByUserIDAndCategoryID_FullMethodName            = "/moviebase.MovieService/GetMoviesByUserIDAndCategoryID"
 	MovieService_GetMoviesByUserID_FullMethodName                         = "/moviebase.MovieService/GetMoviesByUserID"
 	MovieService_GetMoviesByUserIDAndName_FullMethodName                  = "/moviebase.MovieService/GetMoviesByUserIDAndName"
 	MovieService_GetMoviesByUserIDAndCategoryIDByCreatedAt_FullMethodName = "/moviebase.MovieService/GetMoviesByUserIDAndCategoryIDByCreatedAt"
+	MovieService_UpdateMovie_FullMethodName                               = "/moviebase.MovieService/UpdateMovie"
+	MovieService_DeleteMovie_FullMethodName                               = "/moviebase.MovieService/DeleteMovie"
+	MovieService_DeleteMoviesByCategory_FullMethodName                    = "/moviebase.MovieService/DeleteMoviesByCategory"
+	MovieService_GetMovie_FullMethodName                                  = "/moviebase.MovieService/GetMovie"
+	MovieService_ListMoviesByCategory_FullMethodName                      = "/moviebase.MovieService/ListMoviesByCategory"
+	MovieService_SearchMovies_FullMethodName                              = "/moviebase.MovieService/SearchMovies"
 )
 
 // MovieServiceClient is the client API for MovieService service.
@@ -260,10 +502,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MovieServiceClient interface {
 	CreateMovies(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CreateMovieRequest, CreateMoviesResponse], error)
+	CreateMoviesBidi(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CreateMovieRequest, CreateMovieAck], error)
 	GetMoviesByUserIDAndCategoryID(ctx context.Context, in *GetMoviesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetMoviesResponse], error)
 	GetMoviesByUserID(ctx context.Context, in *GetMoviesRequestByUserIDOnly, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetMoviesResponseByUserIDOnly], error)
 	GetMoviesByUserIDAndName(ctx context.Context, in *GetMoviesRequestByUserIDAndName, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetMoviesResponseByUserIDAndName], error)
 	GetMoviesByUserIDAndCategoryIDByCreatedAt(ctx context.Context, in *GetMoviesRequestByUserIDAndCategoryIDByCreatedAt, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetMoviesResponseByUserIDAndCategoryIDByCreatedAt], error)
+	UpdateMovie(ctx context.Context, in *UpdateMovieRequest, opts ...grpc.CallOption) (*UpdateMovieResponse, error)
+	DeleteMovie(ctx context.Context, in *DeleteMovieRequest, opts ...grpc.CallOption) (*DeleteMoviesResponse, error)
+	DeleteMoviesByCategory(ctx context.Context, in *DeleteMoviesByCategoryRequest, opts ...grpc.CallOption) (*DeleteMoviesResponse, error)
+	GetMovie(ctx context.Context, in *GetMovieRequest, opts ...grpc.CallOption) (*MovieResponse, error)
+	ListMoviesByCategory(ctx context.Context, in *ListMoviesByCategoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListMoviesByCategoryResponse], error)
+	SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error)
 }
 
 type movieServiceClient struct {
@@ -287,9 +536,22 @@ func (c *movieServiceClient) CreateMovies(ctx context.Context, opts ...grpc.Call
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MovieService_CreateMoviesClient = grpc.ClientStreamingClient[CreateMovieRequest, CreateMoviesResponse]
 
+func (c *movieServiceClient) CreateMoviesBidi(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CreateMovieRequest, CreateMovieAck], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[1], MovieService_CreateMoviesBidi_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CreateMovieRequest, CreateMovieAck]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_CreateMoviesBidiClient = grpc.BidiStreamingClient[CreateMovieRequest, CreateMovieAck]
+
 func (c *movieServiceClient) GetMoviesByUserIDAndCategoryID(ctx context.Context, in *GetMoviesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetMoviesResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[1], MovieService_GetMoviesByUserIDAndCategoryID_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[2], MovieService_GetMoviesByUserIDAndCategoryID_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -308,7 +570,7 @@ type MovieService_GetMoviesByUserIDAndCategoryIDClient = grpc.ServerStreamingCli
 
 func (c *movieServiceClient) GetMoviesByUserID(ctx context.Context, in *GetMoviesRequestByUserIDOnly, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetMoviesResponseByUserIDOnly], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[2], MovieService_GetMoviesByUserID_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[3], MovieService_GetMoviesByUserID_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -327,7 +589,7 @@ type MovieService_GetMoviesByUserIDClient = grpc.ServerStreamingClient[GetMovies
 
 func (c *movieServiceClient) GetMoviesByUserIDAndName(ctx context.Context, in *GetMoviesRequestByUserIDAndName, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetMoviesResponseByUserIDAndName], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[3], MovieService_GetMoviesByUserIDAndName_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[4], MovieService_GetMoviesByUserIDAndName_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +608,7 @@ type MovieService_GetMoviesByUserIDAndNameClient = grpc.ServerStreamingClient[Ge
 
 func (c *movieServiceClient) GetMoviesByUserIDAndCategoryIDByCreatedAt(ctx context.Context, in *GetMoviesRequestByUserIDAndCategoryIDByCreatedAt, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetMoviesResponseByUserIDAndCategoryIDByCreatedAt], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[4], MovieService_GetMoviesByUserIDAndCategoryIDByCreatedAt_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[5], MovieService_GetMoviesByUserIDAndCategoryIDByCreatedAt_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -363,15 +625,91 @@ func (c *movieServiceClient) GetMoviesByUserIDAndCategoryIDByCreatedAt(ctx conte
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MovieService_GetMoviesByUserIDAndCategoryIDByCreatedAtClient = grpc.ServerStreamingClient[GetMoviesResponseByUserIDAndCategoryIDByCreatedAt]
 
+func (c *movieServiceClient) UpdateMovie(ctx context.Context, in *UpdateMovieRequest, opts ...grpc.CallOption) (*UpdateMovieResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMovieResponse)
+	err := c.cc.Invoke(ctx, MovieService_UpdateMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeleteMovie(ctx context.Context, in *DeleteMovieRequest, opts ...grpc.CallOption) (*DeleteMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieService_DeleteMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeleteMoviesByCategory(ctx context.Context, in *DeleteMoviesByCategoryRequest, opts ...grpc.CallOption) (*DeleteMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieService_DeleteMoviesByCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) GetMovie(ctx context.Context, in *GetMovieRequest, opts ...grpc.CallOption) (*MovieResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieResponse)
+	err := c.cc.Invoke(ctx, MovieService_GetMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ListMoviesByCategory(ctx context.Context, in *ListMoviesByCategoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListMoviesByCategoryResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[6], MovieService_ListMoviesByCategory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListMoviesByCategoryRequest, ListMoviesByCategoryResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_ListMoviesByCategoryClient = grpc.ServerStreamingClient[ListMoviesByCategoryResponse]
+
+func (c *movieServiceClient) SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieService_SearchMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MovieServiceServer is the server API for MovieService service.
 // All implementations must embed UnimplementedMovieServiceServer
 // for forward compatibility.
 type MovieServiceServer interface {
 	CreateMovies(grpc.ClientStreamingServer[CreateMovieRequest, CreateMoviesResponse]) error
+	CreateMoviesBidi(grpc.BidiStreamingServer[CreateMovieRequest, CreateMovieAck]) error
 	GetMoviesByUserIDAndCategoryID(*GetMoviesRequest, grpc.ServerStreamingServer[GetMoviesResponse]) error
 	GetMoviesByUserID(*GetMoviesRequestByUserIDOnly, grpc.ServerStreamingServer[GetMoviesResponseByUserIDOnly]) error
 	GetMoviesByUserIDAndName(*GetMoviesRequestByUserIDAndName, grpc.ServerStreamingServer[GetMoviesResponseByUserIDAndName]) error
 	GetMoviesByUserIDAndCategoryIDByCreatedAt(*GetMoviesRequestByUserIDAndCategoryIDByCreatedAt, grpc.ServerStreamingServer[GetMoviesResponseByUserIDAndCategoryIDByCreatedAt]) error
+	UpdateMovie(context.Context, *UpdateMovieRequest) (*UpdateMovieResponse, error)
+	DeleteMovie(context.Context, *DeleteMovieRequest) (*DeleteMoviesResponse, error)
+	DeleteMoviesByCategory(context.Context, *DeleteMoviesByCategoryRequest) (*DeleteMoviesResponse, error)
+	GetMovie(context.Context, *GetMovieRequest) (*MovieResponse, error)
+	ListMoviesByCategory(*ListMoviesByCategoryRequest, grpc.ServerStreamingServer[ListMoviesByCategoryResponse]) error
+	SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error)
 	mustEmbedUnimplementedMovieServiceServer()
 }
 
@@ -385,6 +723,9 @@ type UnimplementedMovieServiceServer struct{}
 func (UnimplementedMovieServiceServer) CreateMovies(grpc.ClientStreamingServer[CreateMovieRequest, CreateMoviesResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method CreateMovies not implemented")
 }
+func (UnimplementedMovieServiceServer) CreateMoviesBidi(grpc.BidiStreamingServer[CreateMovieRequest, CreateMovieAck]) error {
+	return status.Errorf(codes.Unimplemented, "method CreateMoviesBidi not implemented")
+}
 func (UnimplementedMovieServiceServer) GetMoviesByUserIDAndCategoryID(*GetMoviesRequest, grpc.ServerStreamingServer[GetMoviesResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method GetMoviesByUserIDAndCategoryID not implemented")
 }
@@ -397,6 +738,24 @@ func (UnimplementedMovieServiceServer) GetMoviesByUserIDAndName(*GetMoviesReques
 func (UnimplementedMovieServiceServer) GetMoviesByUserIDAndCategoryIDByCreatedAt(*GetMoviesRequestByUserIDAndCategoryIDByCreatedAt, grpc.ServerStreamingServer[GetMoviesResponseByUserIDAndCategoryIDByCreatedAt]) error {
 	return status.Errorf(codes.Unimplemented, "method GetMoviesByUserIDAndCategoryIDByCreatedAt not implemented")
 }
+func (UnimplementedMovieServiceServer) UpdateMovie(context.Context, *UpdateMovieRequest) (*UpdateMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteMovie(context.Context, *DeleteMovieRequest) (*DeleteMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteMoviesByCategory(context.Context, *DeleteMoviesByCategoryRequest) (*DeleteMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMoviesByCategory not implemented")
+}
+func (UnimplementedMovieServiceServer) GetMovie(context.Context, *GetMovieRequest) (*MovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMovie not implemented")
+}
+func (UnimplementedMovieServiceServer) ListMoviesByCategory(*ListMoviesByCategoryRequest, grpc.ServerStreamingServer[ListMoviesByCategoryResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListMoviesByCategory not implemented")
+}
+func (UnimplementedMovieServiceServer) SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMovies not implemented")
+}
 func (UnimplementedMovieServiceServer) mustEmbedUnimplementedMovieServiceServer() {}
 func (UnimplementedMovieServiceServer) testEmbeddedByValue()                      {}
 
@@ -425,6 +784,13 @@ func _MovieService_CreateMovies_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MovieService_CreateMoviesServer = grpc.ClientStreamingServer[CreateMovieRequest, CreateMoviesResponse]
 
+func _MovieService_CreateMoviesBidi_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MovieServiceServer).CreateMoviesBidi(&grpc.GenericServerStream[CreateMovieRequest, CreateMovieAck]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_CreateMoviesBidiServer = grpc.BidiStreamingServer[CreateMovieRequest, CreateMovieAck]
+
 func _MovieService_GetMoviesByUserIDAndCategoryID_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetMoviesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -469,19 +835,147 @@ func _MovieService_GetMoviesByUserIDAndCategoryIDByCreatedAt_Handler(srv interfa
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MovieService_GetMoviesByUserIDAndCategoryIDByCreatedAtServer = grpc.ServerStreamingServer[GetMoviesResponseByUserIDAndCategoryIDByCreatedAt]
 
+func _MovieService_UpdateMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).UpdateMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_UpdateMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).UpdateMovie(ctx, req.(*UpdateMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteMovie(ctx, req.(*DeleteMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteMoviesByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMoviesByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteMoviesByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteMoviesByCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteMoviesByCategory(ctx, req.(*DeleteMoviesByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_GetMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetMovie(ctx, req.(*GetMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_ListMoviesByCategory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListMoviesByCategoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MovieServiceServer).ListMoviesByCategory(m, &grpc.GenericServerStream[ListMoviesByCategoryRequest, ListMoviesByCategoryResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_ListMoviesByCategoryServer = grpc.ServerStreamingServer[ListMoviesByCategoryResponse]
+
+func _MovieService_SearchMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SearchMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SearchMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SearchMovies(ctx, req.(*SearchMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MovieService_ServiceDesc is the grpc.ServiceDesc for MovieService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var MovieService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "moviebase.MovieService",
 	HandlerType: (*MovieServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateMovie",
+			Handler:    _MovieService_UpdateMovie_Handler,
+		},
+		{
+			MethodName: "DeleteMovie",
+			Handler:    _MovieService_DeleteMovie_Handler,
+		},
+		{
+			MethodName: "DeleteMoviesByCategory",
+			Handler:    _MovieService_DeleteMoviesByCategory_Handler,
+		},
+		{
+			MethodName: "GetMovie",
+			Handler:    _MovieService_GetMovie_Handler,
+		},
+		{
+			MethodName: "SearchMovies",
+			Handler:    _MovieService_SearchMovies_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "CreateMovies",
 			Handler:       _MovieService_CreateMovies_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "CreateMoviesBidi",
+			Handler:       _MovieService_CreateMoviesBidi_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "GetMoviesByUserIDAndCategoryID",
 			Handler:       _MovieService_GetMoviesByUserIDAndCategoryID_Handler,
@@ -502,6 +996,199 @@ var MovieService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MovieService_GetMoviesByUserIDAndCategoryIDByCreatedAt_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListMoviesByCategory",
+			Handler:       _MovieService_ListMoviesByCategory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "movie_proto.proto",
+}
+
+const (
+	AdminService_IssueAPIKey_FullMethodName  = "/moviebase.AdminService/IssueAPIKey"
+	AdminService_ListAPIKeys_FullMethodName  = "/moviebase.AdminService/ListAPIKeys"
+	AdminService_RevokeAPIKey_FullMethodName = "/moviebase.AdminService/RevokeAPIKey"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService manages the API keys service callers authenticate with. Only
+// admins may call it.
+type AdminServiceClient interface {
+	IssueAPIKey(ctx context.Context, in *IssueAPIKeyRequest, opts ...grpc.CallOption) (*IssueAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListAPIKeysResponse], error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) IssueAPIKey(ctx context.Context, in *IssueAPIKeyRequest, opts ...grpc.CallOption) (*IssueAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueAPIKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_IssueAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListAPIKeysResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_ListAPIKeys_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListAPIKeysRequest, ListAPIKeysResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_ListAPIKeysClient = grpc.ServerStreamingClient[ListAPIKeysResponse]
+
+func (c *adminServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService manages the API keys service callers authenticate with. Only
+// admins may call it.
+type AdminServiceServer interface {
+	IssueAPIKey(context.Context, *IssueAPIKeyRequest) (*IssueAPIKeyResponse, error)
+	ListAPIKeys(*ListAPIKeysRequest, grpc.ServerStreamingServer[ListAPIKeysResponse]) error
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) IssueAPIKey(context.Context, *IssueAPIKeyRequest) (*IssueAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueAPIKey not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAPIKeys(*ListAPIKeysRequest, grpc.ServerStreamingServer[ListAPIKeysResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_IssueAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).IssueAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_IssueAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).IssueAPIKey(ctx, req.(*IssueAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAPIKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAPIKeysRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).ListAPIKeys(m, &grpc.GenericServerStream[ListAPIKeysRequest, ListAPIKeysResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_ListAPIKeysServer = grpc.ServerStreamingServer[ListAPIKeysResponse]
+
+func _AdminService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moviebase.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IssueAPIKey",
+			Handler:    _AdminService_IssueAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _AdminService_RevokeAPIKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListAPIKeys",
+			Handler:       _AdminService_ListAPIKeys_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "movie_proto.proto",
 }